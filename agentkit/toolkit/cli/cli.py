@@ -31,6 +31,9 @@ from agentkit.toolkit.cli.cli_deploy import deploy_command
 from agentkit.toolkit.cli.cli_launch import launch_command
 from agentkit.toolkit.cli.cli_status import status_command
 from agentkit.toolkit.cli.cli_destroy import destroy_command
+from agentkit.toolkit.cli.cli_scaffold_tool import scaffold_tool_command
+from agentkit.toolkit.cli.cli_schema_export import schema_export_command
+from agentkit.toolkit.cli.cli_bench_startup import bench_startup_command
 from agentkit.toolkit.cli.cli_memory import memory_app
 from agentkit.toolkit.cli.cli_knowledge import knowledge_app
 from agentkit.toolkit.cli.cli_tools import tools_app
@@ -99,6 +102,9 @@ app.command(name="deploy")(deploy_command)
 app.command(name="launch")(launch_command)
 app.command(name="status")(status_command)
 app.command(name="destroy")(destroy_command)
+app.command(name="scaffold-tool")(scaffold_tool_command)
+app.command(name="schema-export")(schema_export_command)
+app.command(name="bench-startup")(bench_startup_command)
 
 # Sub-app groups
 app.add_typer(memory_app, name="memory")
@@ -0,0 +1,114 @@
+# Copyright (c) 2026 Beijing Volcano Engine Technology Co., Ltd. and/or its affiliates.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+"""AgentKit CLI - Bench-startup command timing cold starts of generated Go agents."""
+
+from typing import Optional
+
+import typer
+from rich.console import Console
+
+# Note: Avoid importing heavy packages at the top to keep CLI startup fast
+
+console = Console()
+
+
+def bench_startup_command(
+    directory: str = typer.Option(
+        ".", "--directory", help="Directory of the Go agent (its go.mod and main.go)"
+    ),
+    runs: int = typer.Option(3, "--runs", help="Number of cold starts to time"),
+    max_startup: Optional[str] = typer.Option(
+        None,
+        "--max-startup",
+        help="Fail when the median time to ready exceeds this duration, e.g. 2s or 500ms",
+    ),
+    timeout: str = typer.Option(
+        "60s", "--timeout", help="How long to wait for each start to get ready"
+    ),
+    port: int = typer.Option(8000, "--port", help="Port the agent serves on"),
+    json_output: bool = typer.Option(
+        False, "--json", help="Print the timings as JSON, e.g. to track them in CI"
+    ),
+):
+    """Build a generated Go agent, boot it and time how long it takes to get ready."""
+    import json
+    from pathlib import Path
+
+    from agentkit.toolkit.utils import go_features
+    from agentkit.toolkit.utils.startup_bench import (
+        READY_PATH,
+        StartupBenchError,
+        bench_startup,
+    )
+
+    if runs < 1:
+        console.print("[red]✗ --runs must be at least 1[/red]")
+        raise typer.Exit(1)
+    timeout_seconds = go_features.parse_duration(timeout)
+    if not timeout_seconds:
+        console.print(f"[red]✗ Invalid --timeout '{timeout}'[/red]")
+        raise typer.Exit(1)
+    max_seconds = None
+    if max_startup is not None:
+        max_seconds = go_features.parse_duration(max_startup)
+        if not max_seconds:
+            console.print(f"[red]✗ Invalid --max-startup '{max_startup}'[/red]")
+            raise typer.Exit(1)
+
+    if not json_output:
+        console.print(
+            f"[bold blue]Building {directory} and starting it {runs} time(s)...[/bold blue]"
+        )
+    try:
+        result = bench_startup(Path(directory), runs, port, timeout_seconds)
+    except StartupBenchError as e:
+        console.print(f"[red]✗ Startup bench failed: {e}[/red]")
+        raise typer.Exit(1)
+
+    exceeded = max_seconds is not None and result.median > max_seconds
+    if json_output:
+        typer.echo(
+            json.dumps(
+                {
+                    "build_seconds": round(result.build_seconds, 3),
+                    "startup_seconds": [round(s, 3) for s in result.startup_seconds],
+                    "median_seconds": round(result.median, 3),
+                    "readiness_probe": result.readiness_probe,
+                    "max_startup_seconds": max_seconds,
+                    "passed": not exceeded,
+                },
+                indent=2,
+            )
+        )
+    else:
+        console.print(f"  Build: {result.build_seconds:.2f}s")
+        for i, seconds in enumerate(result.startup_seconds, 1):
+            console.print(f"  Start {i}: {seconds * 1000:.0f}ms")
+        console.print(
+            f"[bold]Median time to ready: {result.median * 1000:.0f}ms[/bold]"
+        )
+        if not result.readiness_probe:
+            console.print(
+                f"[yellow]No {READY_PATH} endpoint; measured until the server first "
+                "answered. Generate the agent with --warmup to include the warm-up "
+                "model call.[/yellow]"
+            )
+
+    if exceeded:
+        if not json_output:
+            console.print(
+                f"[red]✗ Median time to ready exceeds --max-startup {max_startup}[/red]"
+            )
+        raise typer.Exit(1)
@@ -15,15 +15,16 @@
 """AgentKit CLI - Init command for project initialization."""
 
 from pathlib import Path
-from typing import Optional
+from typing import List, Optional
 
 import typer
 from rich.console import Console
 from rich.table import Table
 from rich.text import Text
 
-from agentkit.toolkit.executors import InitExecutor
+from agentkit.toolkit.executors import InitExecutor, ScaffoldOptions
 from agentkit.toolkit.cli.console_reporter import ConsoleReporter
+from agentkit.toolkit.utils.git_templates import TemplateSourceError
 
 # Note: Avoid importing heavy modules at the top to keep CLI startup fast.
 
@@ -94,15 +95,23 @@ def show_logo():
 
 
 # Get templates from Executor layer
-def _get_templates():
-    """Get templates from InitExecutor."""
+def _get_templates(
+    sample_from_git: Optional[str] = None,
+    refresh: bool = False,
+    no_network: bool = False,
+):
+    """Get templates from InitExecutor, or from a git template source."""
     executor = InitExecutor(reporter=ConsoleReporter())
-    return executor.get_available_templates()
+    try:
+        return executor.get_available_templates(sample_from_git, refresh, no_network)
+    except TemplateSourceError as e:
+        console.print(f"[red]Error: {e}[/red]")
+        raise typer.Exit(1)
 
 
-def display_templates():
+def display_templates(templates: Optional[dict] = None):
     """Display available templates."""
-    templates = _get_templates()
+    templates = templates or _get_templates()
 
     table = Table(
         title="Available Templates", show_header=True, header_style="bold magenta"
@@ -127,9 +136,11 @@ def display_templates():
     console.print(table)
 
 
-def select_template(template_key: Optional[str] = None) -> str:
+def select_template(
+    template_key: Optional[str] = None, templates: Optional[dict] = None
+) -> str:
     """Select a template via CLI option or interactive prompt."""
-    templates = _get_templates()
+    templates = templates or _get_templates()
 
     if template_key:
         # From CLI option
@@ -157,7 +168,7 @@ def select_template(template_key: Optional[str] = None) -> str:
         raise typer.Exit(1)
 
     # Interactive selection
-    display_templates()
+    display_templates(templates)
     console.print(
         f"\n[bold cyan]Please select a template by entering ID/Key/Name (ID range: 1-{len(templates)}):[/bold cyan]"
     )
@@ -198,6 +209,38 @@ def select_template(template_key: Optional[str] = None) -> str:
             raise typer.Exit(0)
 
 
+def _print_explanation(explanation: dict):
+    """Display the --explain description of a generated project."""
+    files = Table(
+        title="Generated Files", show_header=True, header_style="bold magenta"
+    )
+    files.add_column("File", style="cyan")
+    files.add_column("What it does", style="white")
+    for entry in explanation["files"]:
+        files.add_row(entry["path"], entry["role"])
+    console.print()
+    console.print(files)
+
+    if explanation["features"]:
+        features = Table(
+            title="Enabled Features", show_header=True, header_style="bold magenta"
+        )
+        features.add_column("Feature", style="cyan")
+        features.add_column("What it does", style="white")
+        features.add_column("Files", style="green")
+        features.add_column("Set with", style="yellow")
+        for entry in explanation["features"]:
+            features.add_row(
+                entry["name"], entry["summary"], entry["files"], entry["options"]
+            )
+        console.print()
+        console.print(features)
+        console.print(
+            "[dim]  Edit the generated files to customize a feature, or run "
+            "agentkit init again with other options.[/dim]"
+        )
+
+
 def init_command(
     project_name: Optional[str] = typer.Argument(None, help="Project name"),
     template: Optional[str] = typer.Option(
@@ -206,6 +249,31 @@ def init_command(
         "-t",
         help="Project template (accepts ID/Key/Name). Keys: basic, basic_stream",
     ),
+    sample_from_git: Optional[str] = typer.Option(
+        None,
+        "--sample-from-git",
+        help="Take templates from a git repository with an agentkit-templates.yaml manifest (<url>#<ref>); cached per ref",
+    ),
+    refresh: bool = typer.Option(
+        False,
+        "--refresh",
+        help="Fetch the --sample-from-git repository again instead of using the cached checkout",
+    ),
+    no_network: bool = typer.Option(
+        False,
+        "--no-network",
+        help="Offline mode: never reach the network; --sample-from-git only uses cached checkouts",
+    ),
+    init_git: bool = typer.Option(
+        False,
+        "--init-git",
+        help="Initialize the project as a git repository with an initial commit of the generated files",
+    ),
+    explain: bool = typer.Option(
+        False,
+        "--explain",
+        help="After generation, explain what each generated file and enabled feature does and which options change it",
+    ),
     directory: Optional[str] = typer.Option(".", help="Target directory"),
     agent_name: Optional[str] = typer.Option(
         None, "--agent-name", help="Agent name (default: 'Agent')"
@@ -220,6 +288,16 @@ def init_command(
         "--system-prompt",
         help="Agent system prompt (uses a common default system prompt if not provided)",
     ),
+    prompt_fragments: Optional[str] = typer.Option(
+        None,
+        "--prompt-fragments",
+        help="Comma-separated prompt fragment files concatenated into the system prompt (e.g., persona.md,safety.md,task.md)",
+    ),
+    prompt_fragment_separator: str = typer.Option(
+        "\\n\\n",
+        "--prompt-fragment-separator",
+        help="Separator inserted between prompt fragments (\\n and \\t are unescaped)",
+    ),
     model_name: Optional[str] = typer.Option(
         None,
         "--model-name",
@@ -240,6 +318,437 @@ def init_command(
         "--tools",
         help="Comma-separated list of tools to include (e.g., web_search,run_code)",
     ),
+    prompt_lint: bool = typer.Option(
+        False,
+        "--prompt-lint",
+        help="Check the system prompt (length, role statement, placeholders, personal data) and warn before rendering",
+    ),
+    prompt_lint_strict: bool = typer.Option(
+        False,
+        "--prompt-lint-strict",
+        help="Like --prompt-lint, but fail when any issue is found",
+    ),
+    # Go template generation options (basic_go, a2a_go)
+    layout: str = typer.Option(
+        "standalone",
+        "--layout",
+        help="Go templates: project layout, standalone or monorepo (generates into services/<name>/)",
+    ),
+    workspace: str = typer.Option(
+        "go.work",
+        "--workspace",
+        help="Go workspace file (relative to --directory) to register the module in with --layout monorepo",
+    ),
+    stop: Optional[List[str]] = typer.Option(
+        None,
+        "--stop",
+        help="Go templates: stop sequence for the model, repeatable (\\n and \\t are unescaped)",
+    ),
+    frequency_penalty: Optional[float] = typer.Option(
+        None,
+        "--frequency-penalty",
+        help="Go templates: frequency penalty for the model (-2.0 to 2.0)",
+    ),
+    presence_penalty: Optional[float] = typer.Option(
+        None,
+        "--presence-penalty",
+        help="Go templates: presence penalty for the model (-2.0 to 2.0)",
+    ),
+    on_empty_input: str = typer.Option(
+        "passthrough",
+        "--on-empty-input",
+        help="Go templates: how to handle requests without user text: error, default-response or passthrough",
+    ),
+    empty_input_response: Optional[str] = typer.Option(
+        None,
+        "--empty-input-response",
+        help="Go templates: canned reply used with --on-empty-input default-response",
+    ),
+    preprocess: Optional[List[str]] = typer.Option(
+        None,
+        "--preprocess",
+        help="Go templates: pre-processing step applied to user text before each model call, repeatable and in order: trim, lowercase, strip-markdown or expand-abbreviations",
+    ),
+    max_steps: Optional[int] = typer.Option(
+        None,
+        "--max-steps",
+        help="Go templates: cap the model/tool iterations of one request; AGENT_MAX_STEPS overrides it at runtime",
+    ),
+    moderation_url: Optional[str] = typer.Option(
+        None,
+        "--moderation-url",
+        help="Go templates: moderation service that checks user input and model output; MODERATION_URL overrides it at runtime",
+    ),
+    moderation_action: str = typer.Option(
+        "block",
+        "--moderation-action",
+        help="Go templates: what to do with flagged content: block, log or annotate",
+    ),
+    moderation_fail_mode: str = typer.Option(
+        "closed",
+        "--moderation-fail-mode",
+        help="Go templates: when the moderation service fails, reject the request (closed) or continue unmoderated (open)",
+    ),
+    budget_ceiling: Optional[float] = typer.Option(
+        None,
+        "--budget-ceiling",
+        help="Go templates: highest estimated model cost of one request, in the currency of the pricing table; above it the request falls back to --budget-fallback-model",
+    ),
+    budget_fallback_model: Optional[str] = typer.Option(
+        None,
+        "--budget-fallback-model",
+        help="Go templates: cheaper model used for the rest of a request that would exceed --budget-ceiling",
+    ),
+    budget_pricing: Optional[str] = typer.Option(
+        None,
+        "--budget-pricing",
+        help="Go templates: JSON file with per-model token prices for --budget-ceiling (default: bundled table)",
+    ),
+    circuit_breaker: bool = typer.Option(
+        False,
+        "--circuit-breaker",
+        help="Go templates: fail model calls fast after repeated failures, with the fallback response if one is set",
+    ),
+    circuit_breaker_threshold: int = typer.Option(
+        5,
+        "--circuit-breaker-threshold",
+        help="Go templates: consecutive failed model calls that open the circuit breaker",
+    ),
+    circuit_breaker_open_duration: str = typer.Option(
+        "30s",
+        "--circuit-breaker-open-duration",
+        help="Go templates: how long the open circuit breaker rejects model calls before probing, e.g. 30s",
+    ),
+    circuit_breaker_probes: int = typer.Option(
+        1,
+        "--circuit-breaker-probes",
+        help="Go templates: successful probe calls needed to close the circuit breaker again",
+    ),
+    output_schema: Optional[str] = typer.Option(
+        None,
+        "--output-schema",
+        help="Go templates: JSON Schema file the agent's replies must match; invalid replies are repaired, retried or fail with 502",
+    ),
+    output_repair: bool = typer.Option(
+        True,
+        "--output-repair/--no-output-repair",
+        help="Go templates: repair replies that are almost valid JSON (code fences, surrounding prose, trailing commas) before validating them",
+    ),
+    output_retries: int = typer.Option(
+        1,
+        "--output-retries",
+        help="Go templates: how often the model is asked again, with the validation error, for a reply that does not match --output-schema",
+    ),
+    fallback_response: Optional[str] = typer.Option(
+        None,
+        "--fallback-response",
+        help="Go templates: canned reply returned when the model call fails",
+    ),
+    fallback_status: int = typer.Option(
+        200,
+        "--fallback-status",
+        help="Go templates: HTTP status returned with --fallback-response",
+    ),
+    schema_endpoint: str = typer.Option(
+        "auto",
+        "--schema-endpoint",
+        help="Go templates: GET /schema endpoint with the tool declarations: auto, on or off",
+    ),
+    with_replay: bool = typer.Option(
+        False,
+        "--with-replay",
+        help="Go templates: record session transcripts with export and replay endpoints (basic_go)",
+    ),
+    with_attachments: bool = typer.Option(
+        False,
+        "--with-attachments",
+        help="Go templates: accept files uploaded in multipart/form-data /invoke requests and pass them to the agent (basic_go)",
+    ),
+    attachment_max_bytes: int = typer.Option(
+        10 * 1024 * 1024,
+        "--attachment-max-bytes",
+        help="Go templates: largest file in bytes accepted with --with-attachments",
+    ),
+    attachment_types: Optional[List[str]] = typer.Option(
+        None,
+        "--attachment-types",
+        help="Go templates: media type accepted with --with-attachments, repeatable; type/* allows all subtypes (default: text, JSON, PDF, PNG and JPEG)",
+    ),
+    idempotency: bool = typer.Option(
+        False,
+        "--idempotency",
+        help="Go templates: answer POST requests repeating an Idempotency-Key header with the recorded response instead of running the agent again",
+    ),
+    idempotency_ttl: str = typer.Option(
+        "1h",
+        "--idempotency-ttl",
+        help="Go templates: how long the response to an Idempotency-Key is replayed with --idempotency",
+    ),
+    readonly_fs: bool = typer.Option(
+        False,
+        "--readonly-fs",
+        help="Go templates: run on a read-only root filesystem (logs to stdout, temp files under /tmp)",
+    ),
+    prompt_version: Optional[str] = typer.Option(
+        None,
+        "--prompt-version",
+        help="Go templates: prompt version tag stamped into the X-Prompt-Version header, logs and /version",
+    ),
+    config_driven: bool = typer.Option(
+        False,
+        "--config-driven",
+        help="Go templates: read the prompt and model from config.yaml and serve POST /reload to apply edits without a restart",
+    ),
+    reload_token_env: str = typer.Option(
+        "AGENT_RELOAD_TOKEN",
+        "--reload-token-env",
+        help="Go templates: environment variable holding the bearer token required by POST /reload",
+    ),
+    config_history: bool = typer.Option(
+        False,
+        "--config-history",
+        help="Go templates: record each loaded config.yaml version with a timestamp and diff, served on GET /config/history (with --config-driven)",
+    ),
+    config_history_limit: int = typer.Option(
+        100,
+        "--config-history-limit",
+        help="Go templates: number of configuration changes kept by --config-history",
+    ),
+    path_prefix: Optional[str] = typer.Option(
+        None,
+        "--path-prefix",
+        help="Go templates: serve all routes under a path prefix (e.g. /agents/myagent)",
+    ),
+    model_call_timeout: Optional[str] = typer.Option(
+        None,
+        "--model-call-timeout",
+        help="Go templates: deadline of each model call (e.g. 45s), separate from the HTTP timeouts; a timed-out call fails with a distinct error and 504 (basic_go)",
+    ),
+    verify_signature: Optional[str] = typer.Option(
+        None,
+        "--verify-signature",
+        help="Go templates: verify request body signatures and reject mismatches with 401 (hmac-sha256)",
+    ),
+    signature_header: str = typer.Option(
+        "X-Signature",
+        "--signature-header",
+        help="Go templates: header carrying the request signature",
+    ),
+    signature_secret_env: str = typer.Option(
+        "AGENT_SIGNATURE_SECRET",
+        "--signature-secret-env",
+        help="Go templates: environment variable holding the signing secret",
+    ),
+    warmup: bool = typer.Option(
+        False,
+        "--warmup",
+        help="Go templates: send a warm-up request at startup before /readyz reports ready",
+    ),
+    warmup_timeout: str = typer.Option(
+        "30s",
+        "--warmup-timeout",
+        help="Go templates: upper bound of the warm-up step; failures are logged and ignored",
+    ),
+    pprof: bool = typer.Option(
+        False,
+        "--pprof",
+        help="Go templates: serve net/http/pprof profiling endpoints on a separate address",
+    ),
+    pprof_addr: str = typer.Option(
+        "127.0.0.1:6060",
+        "--pprof-addr",
+        help="Go templates: listen address of the pprof endpoints",
+    ),
+    pprof_token_env: Optional[str] = typer.Option(
+        None,
+        "--pprof-token-env",
+        help="Go templates: environment variable holding a bearer token required by the pprof endpoints",
+    ),
+    dump_config: bool = typer.Option(
+        False,
+        "--dump-config",
+        help="Go templates: add a --dump-config flag to the agent that prints its resolved configuration, secrets redacted, and exits",
+    ),
+    probe_deps: bool = typer.Option(
+        False,
+        "--probe-deps",
+        help="Go templates: add a --probe-deps flag to the agent that checks the model endpoint and configured stores are reachable, reports latency and exits nonzero on failure",
+    ),
+    probe_timeout: str = typer.Option(
+        "5s",
+        "--probe-timeout",
+        help="Go templates: upper bound of each --probe-deps check",
+    ),
+    transport: str = typer.Option(
+        "http",
+        "--transport",
+        help="Go templates: agent API transport: http, grpc (gRPC ChatService only) or both (basic_go)",
+    ),
+    grpc_port: int = typer.Option(
+        50051,
+        "--grpc-port",
+        help="Go templates: port of the gRPC ChatService",
+    ),
+    context_headers: Optional[List[str]] = typer.Option(
+        None,
+        "--context-headers",
+        help="Go templates: request header injected into a per-turn system message as Header=label, repeatable (basic_go)",
+    ),
+    tenant_header: Optional[str] = typer.Option(
+        None,
+        "--tenant-header",
+        help="Go templates: header identifying the tenant, e.g. X-Tenant-ID; requests without it are rejected and sessions, memory and logs are scoped to the tenant (basic_go)",
+    ),
+    localize: bool = typer.Option(
+        False,
+        "--localize",
+        help="Go templates: answer in the language negotiated from the Accept-Language header (basic_go)",
+    ),
+    localize_default: str = typer.Option(
+        "en",
+        "--localize-default",
+        help="Go templates: language used with --localize when the request names no supported language",
+    ),
+    localize_languages: Optional[List[str]] = typer.Option(
+        None,
+        "--localize-languages",
+        help="Go templates: language the agent answers in with --localize besides the default, repeatable",
+    ),
+    response_headers: Optional[List[str]] = typer.Option(
+        None,
+        "--response-headers",
+        help="Go templates: header set on every response as Name:value, repeatable",
+    ),
+    secure_headers: bool = typer.Option(
+        False,
+        "--secure-headers",
+        help="Go templates: set X-Content-Type-Options, Cache-Control, X-Frame-Options and Referrer-Policy on every response",
+    ),
+    allow_model_override: Optional[List[str]] = typer.Option(
+        None,
+        "--allow-model-override",
+        help="Go templates: model a request may select with the X-Model header, repeatable; other models get 400 (basic_go)",
+    ),
+    prompt_cache: Optional[str] = typer.Option(
+        None,
+        "--prompt-cache",
+        help="Go templates: serve repeated identical prompts from a cache, inmemory or redis (basic_go)",
+    ),
+    prompt_cache_ttl: str = typer.Option(
+        "10m",
+        "--prompt-cache-ttl",
+        help="Go templates: how long a cached model response is served with --prompt-cache",
+    ),
+    session_ttl: Optional[str] = typer.Option(
+        None,
+        "--session-ttl",
+        help="Go templates: evict sessions idle for longer than this duration, e.g. 30m; by default sessions are kept until restart",
+    ),
+    parallel_tools: str = typer.Option(
+        "off",
+        "--parallel-tools",
+        help="Go templates: run the tool calls of one model response concurrently (on) or one by one (off)",
+    ),
+    parallel_tools_limit: int = typer.Option(
+        4,
+        "--parallel-tools-limit",
+        help="Go templates: maximum number of tool calls running at the same time with --parallel-tools on",
+    ),
+    tool_progress: bool = typer.Option(
+        False,
+        "--tool-progress",
+        help="Go templates: stream start, heartbeat and completion events of running tool calls to SSE clients",
+    ),
+    tool_progress_interval: str = typer.Option(
+        "5s",
+        "--tool-progress-interval",
+        help="Go templates: how often a running tool call sends a heartbeat event with --tool-progress",
+    ),
+    tool_result_format: Optional[str] = typer.Option(
+        None,
+        "--tool-result-format",
+        help="Go templates: normalize tool results and errors sent to the model as json or text",
+    ),
+    tool_result_max_bytes: int = typer.Option(
+        16384,
+        "--tool-result-max-bytes",
+        help="Go templates: truncate normalized tool results larger than this many bytes",
+    ),
+    access_log: bool = typer.Option(
+        False,
+        "--access-log",
+        help="Go templates: log every request with status, duration and the start of both bodies",
+    ),
+    access_log_body_limit: int = typer.Option(
+        1024,
+        "--access-log-body-limit",
+        help="Go templates: body bytes logged per request and direction with --access-log; 0 logs no bodies",
+    ),
+    redact_logs: Optional[str] = typer.Option(
+        None,
+        "--redact-logs",
+        help="Go templates: file of regular expressions (one per line) masked in --access-log lines",
+    ),
+    tool_package: Optional[List[str]] = typer.Option(
+        None,
+        "--tool-package",
+        help="Go templates: import path of a tool package created with agentkit scaffold-tool, repeatable",
+    ),
+    tool_registry_url: Optional[str] = typer.Option(
+        None,
+        "--tool-registry-url",
+        help="Go templates: load tool definitions from this registry at startup instead of baking them in",
+    ),
+    tool_registry_policy: str = typer.Option(
+        "fail",
+        "--tool-registry-policy",
+        help="Go templates: when the tool registry is unavailable: fail (exit) or skip (start without its tools)",
+    ),
+    tool_registry_retries: int = typer.Option(
+        2,
+        "--tool-registry-retries",
+        help="Go templates: retries of the tool registry request at startup",
+    ),
+    with_loadtest: bool = typer.Option(
+        False,
+        "--with-loadtest",
+        help="Go templates: generate a k6 load-test script (loadtest.js) for the agent endpoint",
+    ),
+    loadtest_vus: int = typer.Option(
+        10,
+        "--loadtest-vus",
+        help="Go templates: default concurrency (virtual users) of the load test",
+    ),
+    loadtest_duration: str = typer.Option(
+        "30s",
+        "--loadtest-duration",
+        help="Go templates: default duration of the load test (e.g. 30s, 5m)",
+    ),
+    with_thinking_bench: bool = typer.Option(
+        False,
+        "--with-thinking-bench",
+        help="Go templates: generate a benchmark (thinkingbench/) comparing the model with thinking enabled and disabled",
+    ),
+    thinking_bench_runs: int = typer.Option(
+        3,
+        "--thinking-bench-runs",
+        help="Go templates: default number of times the benchmark sends each prompt per thinking mode",
+    ),
+    compress: Optional[str] = typer.Option(
+        None,
+        "--compress",
+        help="Go templates: compress responses honoring Accept-Encoding (gzip)",
+    ),
+    compress_min_size: int = typer.Option(
+        1024,
+        "--compress-min-size",
+        help="Go templates: smallest response body in bytes compressed with --compress",
+    ),
+    inject_clock: bool = typer.Option(
+        False,
+        "--inject-clock",
+        help="Go templates: read the time through an injectable Clock (prompt date, log timestamps) with a fixed-clock test",
+    ),
     # New parameters for wrapping existing Agent files
     from_agent: Optional[str] = typer.Option(
         None,
@@ -275,14 +784,132 @@ def init_command(
     # ===== UI Layer: Display logo =====
     show_logo()
 
+    if refresh and not sample_from_git:
+        console.print("[red]Error: --refresh requires --sample-from-git.[/red]")
+        raise typer.Exit(1)
+    if from_agent and sample_from_git:
+        console.print(
+            "[red]Error: --sample-from-git cannot be combined with --from-agent; "
+            "wrapping an agent file uses no template.[/red]"
+        )
+        raise typer.Exit(1)
+
+    # Fetch a git template source once, honouring --refresh; later lookups,
+    # including the executor's, reuse that checkout.
+    templates = (
+        _get_templates(sample_from_git, refresh, no_network)
+        if sample_from_git
+        else None
+    )
+
     # Optional: list templates and exit
     if list_templates:
-        display_templates()
+        display_templates(templates)
         raise typer.Exit(0)
 
     # ===== Mode Detection: Template or Wrapper mode =====
     executor = InitExecutor(reporter=ConsoleReporter())
 
+    scaffold_options = ScaffoldOptions(
+        frequency_penalty=frequency_penalty,
+        presence_penalty=presence_penalty,
+        on_empty_input=on_empty_input,
+        prompt_lint=prompt_lint,
+        prompt_lint_strict=prompt_lint_strict,
+        layout=layout,
+        workspace=workspace,
+        max_steps=max_steps,
+        preprocess=preprocess,
+        moderation_url=moderation_url,
+        moderation_action=moderation_action,
+        moderation_fail_mode=moderation_fail_mode,
+        budget_ceiling=budget_ceiling,
+        budget_fallback_model=budget_fallback_model,
+        budget_pricing=budget_pricing,
+        circuit_breaker=circuit_breaker,
+        circuit_breaker_threshold=circuit_breaker_threshold,
+        circuit_breaker_open_duration=circuit_breaker_open_duration,
+        circuit_breaker_probes=circuit_breaker_probes,
+        output_schema=output_schema,
+        output_repair=output_repair,
+        output_retries=output_retries,
+        fallback_response=fallback_response,
+        fallback_status=fallback_status,
+        schema_endpoint=schema_endpoint,
+        inject_clock=inject_clock,
+        compress=compress,
+        compress_min_size=compress_min_size,
+        with_replay=with_replay,
+        with_attachments=with_attachments,
+        attachment_max_bytes=attachment_max_bytes,
+        attachment_types=attachment_types,
+        idempotency=idempotency,
+        idempotency_ttl=idempotency_ttl,
+        readonly_fs=readonly_fs,
+        prompt_version=prompt_version,
+        config_driven=config_driven,
+        reload_token_env=reload_token_env,
+        config_history=config_history,
+        config_history_limit=config_history_limit,
+        path_prefix=path_prefix,
+        model_call_timeout=model_call_timeout,
+        verify_signature=verify_signature,
+        signature_header=signature_header,
+        signature_secret_env=signature_secret_env,
+        warmup=warmup,
+        warmup_timeout=warmup_timeout,
+        pprof=pprof,
+        pprof_addr=pprof_addr,
+        pprof_token_env=pprof_token_env,
+        dump_config=dump_config,
+        probe_deps=probe_deps,
+        probe_timeout=probe_timeout,
+        transport=transport,
+        grpc_port=grpc_port,
+        context_headers=context_headers,
+        tenant_header=tenant_header,
+        localize=localize,
+        localize_default=localize_default,
+        localize_languages=localize_languages,
+        response_headers=response_headers,
+        secure_headers=secure_headers,
+        allow_model_override=allow_model_override,
+        prompt_cache=prompt_cache,
+        prompt_cache_ttl=prompt_cache_ttl,
+        session_ttl=session_ttl,
+        parallel_tools=parallel_tools,
+        parallel_tools_limit=parallel_tools_limit,
+        tool_progress=tool_progress,
+        tool_progress_interval=tool_progress_interval,
+        tool_result_format=tool_result_format,
+        tool_result_max_bytes=tool_result_max_bytes,
+        access_log=access_log,
+        access_log_body_limit=access_log_body_limit,
+        redact_logs=redact_logs,
+        tool_package=tool_package,
+        tool_registry_url=tool_registry_url,
+        tool_registry_policy=tool_registry_policy,
+        tool_registry_retries=tool_registry_retries,
+        with_loadtest=with_loadtest,
+        loadtest_vus=loadtest_vus,
+        loadtest_duration=loadtest_duration,
+        with_thinking_bench=with_thinking_bench,
+        thinking_bench_runs=thinking_bench_runs,
+    )
+    if stop:
+        scaffold_options.stop = [
+            seq.replace("\\n", "\n").replace("\\t", "\t") for seq in stop
+        ]
+    if prompt_fragments:
+        scaffold_options.prompt_fragments = [
+            f.strip() for f in prompt_fragments.split(",") if f.strip()
+        ]
+        scaffold_options.prompt_fragment_separator = (
+            prompt_fragment_separator.replace("\\n", "\n").replace("\\t", "\t")
+        )
+    if empty_input_response is not None:
+        scaffold_options.empty_input_response = empty_input_response
+
     if from_agent:
         # ===== WRAPPER MODE: Wrap existing Agent file =====
         console.print("[bold cyan]🔄 Wrapping existing Agent file[/bold cyan]\n")
@@ -310,15 +937,19 @@ def init_command(
             agent_var_name=agent_var,
             wrapper_type=wrapper_type,
             directory=directory,
+            no_network=no_network,
+            init_git=init_git,
+            explain=explain,
+            scaffold_options=scaffold_options,
         )
     else:
         # ===== TEMPLATE MODE: Create from template =====
 
         # ===== UI Layer: Interactive template selection =====
-        template_key = select_template(template)
+        template_key = select_template(template, templates)
 
         # Get template info for UI display
-        templates = _get_templates()
+        templates = templates or _get_templates()
         template_info = templates[template_key]
 
         # ===== UI Layer: Display creation info =====
@@ -341,6 +972,11 @@ def init_command(
             model_api_base=model_api_base,
             model_api_key=model_api_key,
             tools=tools,
+            scaffold_options=scaffold_options,
+            sample_from_git=sample_from_git,
+            no_network=no_network,
+            init_git=init_git,
+            explain=explain,
         )
 
     # ===== UI Layer: Display results =====
@@ -371,6 +1007,10 @@ def init_command(
             console.print("\n[bold cyan]Created files:[/bold cyan]")
             for file in result.created_files:
                 console.print(f"  [green]✓[/green] {file}")
+        if "init_git" in result.metadata:
+            console.print(f"\n[cyan]Git: {result.metadata['init_git']}[/cyan]")
+        if "explanation" in result.metadata:
+            _print_explanation(result.metadata["explanation"])
 
         # Display global config info if exists
         from agentkit.toolkit.config import global_config_exists, get_global_config
@@ -422,6 +1062,16 @@ def init_command(
         console.print("  1. Review and modify the generated files")
         console.print("  2. Use [bold]agentkit config[/bold] to configure your agent")
         console.print("  3. Use [bold]agentkit launch[/bold] to build and deploy")
+        if "offline_notes" in result.metadata:
+            console.print("\n[yellow]Offline mode (--no-network):[/yellow]")
+            for note in result.metadata["offline_notes"]:
+                console.print(f"  • {note}")
+        elif not from_agent and scaffold_options.tool_package:
+            console.print(
+                "\n[yellow]Add the tool packages to go.mod before building:[/yellow]"
+            )
+            for package in scaffold_options.tool_package:
+                console.print(f"  go get {package}")
         console.print()
     else:
         # Error output
@@ -0,0 +1,88 @@
+# Copyright (c) 2026 Beijing Volcano Engine Technology Co., Ltd. and/or its affiliates.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+"""AgentKit CLI - Scaffold-tool command generating reusable Go tool packages."""
+
+from typing import Optional
+
+import typer
+from rich.console import Console
+
+# Note: Avoid importing heavy packages at the top to keep CLI startup fast
+
+console = Console()
+
+
+def scaffold_tool_command(
+    name: str = typer.Option(
+        ..., "--name", help="Tool name the model calls (e.g. geocode, get_weather)"
+    ),
+    module: Optional[str] = typer.Option(
+        None,
+        "--module",
+        help="Go module path of --directory (e.g. github.com/org/tools); defaults to the module in its go.mod",
+    ),
+    directory: str = typer.Option(
+        ".", "--directory", help="Module root the package is generated in"
+    ),
+    description: Optional[str] = typer.Option(
+        None, "--description", help="Tool description shown to the model"
+    ),
+    no_network: bool = typer.Option(
+        False,
+        "--no-network",
+        help="Offline mode: pin a new go.mod and go.sum to the bundled versions instead of requiring go mod tidy",
+    ),
+):
+    """Generate a standalone Go package for one tool, reusable across agents."""
+    from agentkit.toolkit.executors import InitExecutor
+    from agentkit.toolkit.cli.console_reporter import ConsoleReporter
+
+    executor = InitExecutor(reporter=ConsoleReporter())
+    result = executor.scaffold_tool(
+        name=name,
+        module=module,
+        directory=directory,
+        description=description,
+        no_network=no_network,
+    )
+
+    if not result.success:
+        console.print(f"[red]✗ Failed to create tool package: {result.error}[/red]")
+        raise typer.Exit(1)
+
+    import_path = result.metadata["import_path"]
+    console.print(f"[bold blue]✨ Tool package created: {import_path}[/bold blue]")
+    console.print("\n[bold cyan]Created files:[/bold cyan]")
+    for file in result.created_files:
+        console.print(f"  [green]✓[/green] {file}")
+
+    console.print("\n[bold cyan]Next steps:[/bold cyan]")
+    console.print(f"  1. Implement Handler in {result.created_files[0]}")
+    if result.metadata["pinned"]:
+        console.print(
+            "  2. Run [bold]go test ./...[/bold] in the module (bundled go.mod/go.sum pins)"
+        )
+    elif no_network:
+        console.print(
+            "  2. Run [bold]go test ./...[/bold] in the module; skipped go mod tidy (--no-network)"
+        )
+    else:
+        console.print(
+            "  2. Run [bold]go mod tidy && go test ./...[/bold] in the module"
+        )
+    console.print(
+        f"  3. Add it to an agent with [bold]agentkit init --template basic_go --tool-package {import_path}[/bold]"
+    )
+    console.print()
@@ -0,0 +1,71 @@
+# Copyright (c) 2026 Beijing Volcano Engine Technology Co., Ltd. and/or its affiliates.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+"""AgentKit CLI - Schema-export command describing init sample variables."""
+
+from typing import Optional
+
+import typer
+from rich.console import Console
+
+# Note: Avoid importing heavy packages at the top to keep CLI startup fast
+
+console = Console(stderr=True)
+
+
+def schema_export_command(
+    sample: str = typer.Option(
+        ...,
+        "--sample",
+        help="Template or sample name (e.g. basic_go, veadk_go_basic)",
+    ),
+    sample_from_git: Optional[str] = typer.Option(
+        None,
+        "--sample-from-git",
+        help="Git template source <url>#<ref> whose agentkit-templates.yaml declares the sample",
+    ),
+    refresh: bool = typer.Option(
+        False, "--refresh", help="Fetch --sample-from-git again even if it is cached"
+    ),
+    no_network: bool = typer.Option(
+        False, "--no-network", help="Only use a cached checkout of --sample-from-git"
+    ),
+    output: Optional[str] = typer.Option(
+        None, "--output", "-o", help="Write the schema to this file instead of stdout"
+    ),
+):
+    """Print the variables of an init sample as a JSON Schema."""
+    import json
+    from pathlib import Path
+
+    from agentkit.toolkit.executors import InitExecutor
+
+    executor = InitExecutor()
+    result = executor.export_vars_schema(
+        sample=sample,
+        sample_from_git=sample_from_git,
+        refresh=refresh,
+        no_network=no_network,
+    )
+
+    if not result.success:
+        console.print(f"[red]✗ Failed to export schema: {result.error}[/red]")
+        raise typer.Exit(1)
+
+    text = json.dumps(result.metadata["schema"], indent=2, ensure_ascii=False)
+    if output:
+        Path(output).write_text(text + "\n", encoding="utf-8")
+        console.print(f"[green]✓ Schema of '{result.template}' written to {output}[/green]")
+    else:
+        typer.echo(text)
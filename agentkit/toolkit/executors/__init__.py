@@ -31,7 +31,7 @@ from .deploy_executor import DeployExecutor
 from .invoke_executor import InvokeExecutor
 from .status_executor import StatusExecutor
 from .lifecycle_executor import LifecycleExecutor
-from .init_executor import InitExecutor
+from .init_executor import InitExecutor, ScaffoldOptions
 
 # Re-export PreflightMode from models for convenience
 from agentkit.toolkit.models import PreflightMode
@@ -45,6 +45,7 @@ __all__ = [
     "StatusExecutor",
     "LifecycleExecutor",
     "InitExecutor",
+    "ScaffoldOptions",
     "PreflightMode",
     "ServiceNotEnabledException",
 ]
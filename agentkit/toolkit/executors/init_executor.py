@@ -25,17 +25,30 @@ Key responsibilities:
 - Global config fallback for cloud resources (CR, TOS)
 """
 
+import copy
+import json
 import random
 import re
 import shutil
 import os
+from dataclasses import asdict, dataclass, fields
 from pathlib import Path
-from typing import Optional, Dict, Any, List
+from typing import Optional, Dict, Any, List, Set, Tuple
 
 from agentkit.toolkit.models import InitResult
 from agentkit.toolkit.models import AgentFileInfo
 from .base_executor import BaseExecutor
 from ..utils import AgentParser
+from ..utils import go_features
+from ..utils import git_init
+from ..utils import init_explain
+from ..utils import vars_schema
+from ..utils import git_templates
+from ..utils.prompt_fragments import DEFAULT_FRAGMENT_SEPARATOR, compose_prompt
+from ..utils.prompt_lint import lint_prompt
+from ..utils.log_redaction import load_redact_patterns
+from ..utils.model_pricing import load_model_pricing
+from ..utils.output_schema import load_output_schema
 from agentkit.toolkit.config import (
     get_config,
     DEFAULT_IMAGE_TAG,
@@ -112,6 +125,7 @@ TEMPLATES = {
         "language_version": "1.24",
         "description": "Basic Agent App Based on the VeADK-Go Framework",
         "type": "Basic App",
+        "go_features": True,
     },
     "a2a_go": {
         "filepath": "veadk_go_a2a",
@@ -120,9 +134,305 @@ TEMPLATES = {
         "language_version": "1.24",
         "description": "A2A Application Based on the VeADK-Go Framework",
         "type": "A2A App",
+        "go_features": True,
     },
 }
 
+PROJECT_LAYOUTS = ("standalone", "monorepo")
+# Monorepo layout places each agent under <workspace root>/services/<name>/.
+MONOREPO_SERVICES_DIR = "services"
+
+
+@dataclass
+class ScaffoldOptions:
+    """
+    Generation options for template-based init (from CLI, not persisted to config file).
+
+    Go feature fields are exposed to the Go templates under the same name and are
+    only accepted by templates that declare ``go_features``.
+    """
+
+    stop: Optional[List[str]] = None
+    """Stop sequences rendered into the model config"""
+
+    frequency_penalty: Optional[float] = None
+    """Frequency penalty (-2.0 to 2.0) rendered into the model config"""
+
+    presence_penalty: Optional[float] = None
+    """Presence penalty (-2.0 to 2.0) rendered into the model config"""
+
+    on_empty_input: str = "passthrough"
+    """Behavior when a request carries no user text: error, default-response or passthrough"""
+
+    empty_input_response: str = go_features.DEFAULT_EMPTY_INPUT_RESPONSE
+    """Canned reply returned when on_empty_input is default-response"""
+
+    preprocess: Optional[List[str]] = None
+    """Pre-processing steps applied to user text before each model call, in order"""
+
+    max_steps: Optional[int] = None
+    """Maximum model calls per request (AGENT_MAX_STEPS overrides it); None means no cap"""
+
+    moderation_url: Optional[str] = None
+    """Moderation service checking user input and model output (MODERATION_URL overrides it); None disables it"""
+
+    moderation_action: str = "block"
+    """What to do with flagged content (block, log, annotate)"""
+
+    moderation_fail_mode: str = "closed"
+    """Whether requests fail (closed) or go on unmoderated (open) when moderation fails"""
+
+    budget_ceiling: Optional[float] = None
+    """Highest estimated model cost of one request; None disables the budget"""
+
+    budget_fallback_model: Optional[str] = None
+    """Cheaper model a request falls back to when the budget ceiling would be exceeded"""
+
+    budget_pricing: Optional[str] = None
+    """JSON file with per-model token prices; None uses the bundled pricing table"""
+
+    circuit_breaker: bool = False
+    """Fail model calls fast while the provider keeps failing"""
+
+    circuit_breaker_threshold: int = 5
+    """Consecutive failed model calls that open the breaker"""
+
+    circuit_breaker_open_duration: str = "30s"
+    """How long the open breaker rejects model calls before probing"""
+
+    circuit_breaker_probes: int = 1
+    """Successful probe calls needed to close the breaker again"""
+
+    output_schema: Optional[str] = None
+    """JSON Schema file the replies must match; None leaves replies unchecked"""
+
+    output_repair: bool = True
+    """Repair replies that are almost valid JSON (code fences, prose, trailing commas)"""
+
+    output_retries: int = 1
+    """How often the model is asked again for a reply that does not match the schema"""
+
+    fallback_response: Optional[str] = None
+    """Canned reply returned when the model call fails; None disables the fallback"""
+
+    fallback_status: int = go_features.DEFAULT_FALLBACK_STATUS
+    """HTTP status returned with the fallback response"""
+
+    schema_endpoint: str = "auto"
+    """GET /schema tool declarations endpoint: auto (served when the agent has tools), on or off"""
+
+    compress: Optional[str] = None
+    """Response compression installed in the gateway (gzip); None disables it"""
+
+    compress_min_size: int = 1024
+    """Smallest response body in bytes that is compressed"""
+
+    inject_clock: bool = False
+    """Generate an injectable Clock used for the prompt's current time and log timestamps"""
+
+    with_replay: bool = False
+    """Record session transcripts and generate export/replay endpoints (basic_go)"""
+
+    with_attachments: bool = False
+    """Accept file uploads in multipart /invoke requests (basic_go)"""
+
+    attachment_max_bytes: int = 10 * 1024 * 1024
+    """Largest uploaded file in bytes"""
+
+    attachment_types: Optional[List[str]] = None
+    """Media types of accepted uploads, type/* allowing all subtypes; None uses the defaults"""
+
+    idempotency: bool = False
+    """Replay the recorded response to a repeated Idempotency-Key header"""
+
+    idempotency_ttl: str = "1h"
+    """How long the response to an Idempotency-Key is replayed"""
+
+    readonly_fs: bool = False
+    """Generate code compatible with a read-only root filesystem (writes only under /tmp)"""
+
+    prompt_version: Optional[str] = None
+    """Prompt version stamped into response headers, logs and /version"""
+
+    config_driven: bool = False
+    """Read the prompt and model from config.yaml and reload them on POST /reload"""
+
+    reload_token_env: str = "AGENT_RELOAD_TOKEN"
+    """Environment variable holding the bearer token required by POST /reload"""
+
+    config_history: bool = False
+    """Record each loaded configuration version with its diff and serve GET /config/history"""
+
+    config_history_limit: int = 100
+    """Number of configuration changes kept by the history"""
+
+    path_prefix: Optional[str] = None
+    """Path prefix all generated routes are served under, e.g. /agents/myagent"""
+
+    model_call_timeout: Optional[str] = None
+    """Deadline of each model call (e.g. 45s); shorter than the HTTP write timeout"""
+
+    verify_signature: Optional[str] = None
+    """Request signature verification (hmac-sha256); None disables it"""
+
+    signature_header: str = "X-Signature"
+    """Header carrying the request signature"""
+
+    signature_secret_env: str = "AGENT_SIGNATURE_SECRET"
+    """Environment variable holding the signing secret"""
+
+    warmup: bool = False
+    """Send a warm-up request at startup before /readyz reports ready"""
+
+    warmup_timeout: str = "30s"
+    """Upper bound of the warm-up step"""
+
+    pprof: bool = False
+    """Serve net/http/pprof on a separate address"""
+
+    pprof_addr: str = "127.0.0.1:6060"
+    """Listen address of the pprof endpoints"""
+
+    pprof_token_env: Optional[str] = None
+    """Environment variable holding the bearer token required by pprof; None leaves it open"""
+
+    dump_config: bool = False
+    """Add a --dump-config flag printing the resolved configuration, secrets redacted"""
+
+    probe_deps: bool = False
+    """Add a --probe-deps flag checking that the model endpoint and configured stores are reachable"""
+
+    probe_timeout: str = "5s"
+    """Upper bound of each --probe-deps check"""
+
+    transport: str = "http"
+    """Agent API transport (http, grpc, both); grpc serves only the gRPC ChatService"""
+
+    grpc_port: int = 50051
+    """Port of the gRPC ChatService"""
+
+    context_headers: Optional[List[str]] = None
+    """Request headers injected into a per-turn system message, as 'Header=label' entries"""
+
+    tenant_header: Optional[str] = None
+    """Header identifying the tenant that sessions, memory and logs are scoped to; None disables tenancy"""
+
+    localize: bool = False
+    """Answer in the language negotiated from the Accept-Language header"""
+
+    localize_default: str = "en"
+    """Language used when the request names no supported language"""
+
+    localize_languages: Optional[List[str]] = None
+    """Languages the agent answers in besides localize_default"""
+
+    response_headers: Optional[List[str]] = None
+    """Headers set on every response, as 'Name: value' entries"""
+
+    secure_headers: bool = False
+    """Add the default security headers to every response"""
+
+    allow_model_override: Optional[List[str]] = None
+    """Models a request may select with the X-Model header; None disables the override"""
+
+    prompt_cache: Optional[str] = None
+    """Cache model responses of identical prompts (inmemory, redis); None disables it"""
+
+    prompt_cache_ttl: str = "10m"
+    """How long a cached model response is served"""
+
+    session_ttl: Optional[str] = None
+    """Evict sessions idle for longer than this duration; None keeps them until restart"""
+
+    parallel_tools: str = "off"
+    """Run the tool calls of one model response concurrently (on) or one by one (off)"""
+
+    parallel_tools_limit: int = 4
+    """Maximum number of tool calls running at the same time with parallel_tools on"""
+
+    tool_progress: bool = False
+    """Stream progress events of running tool calls to SSE clients"""
+
+    tool_progress_interval: str = "5s"
+    """How often a running tool call sends a heartbeat event"""
+
+    tool_result_format: Optional[str] = None
+    """Normalize tool results sent to the model (json, text); None leaves them as is"""
+
+    tool_result_max_bytes: int = 16384
+    """Encoded size above which a normalized tool result is truncated"""
+
+    access_log: bool = False
+    """Log every request with status, duration and the start of both bodies"""
+
+    access_log_body_limit: int = 1024
+    """Number of body bytes logged per request and direction; 0 logs no bodies"""
+
+    redact_logs: Optional[str] = None
+    """File of regular expressions masked in access log lines"""
+
+    tool_package: Optional[List[str]] = None
+    """Import paths of tool packages (agentkit scaffold-tool) added to the agent"""
+
+    tool_registry_url: Optional[str] = None
+    """Registry the agent loads its tool definitions from at startup"""
+
+    tool_registry_policy: str = "fail"
+    """Behavior when the tool registry stays unavailable: fail or skip"""
+
+    tool_registry_retries: int = 2
+    """Retries of the tool registry request before applying the policy"""
+
+    with_loadtest: bool = False
+    """Generate a k6 load-test script (loadtest.js) for the agent endpoint"""
+
+    loadtest_vus: int = 10
+    """Default number of concurrent virtual users in the load test"""
+
+    loadtest_duration: str = "30s"
+    """Default load test duration (k6 duration, e.g. 30s, 5m)"""
+
+    with_thinking_bench: bool = False
+    """Generate a benchmark (thinkingbench/) comparing model thinking enabled and disabled"""
+
+    thinking_bench_runs: int = 3
+    """Default number of times the benchmark sends each prompt per thinking mode"""
+
+    prompt_fragments: Optional[List[str]] = None
+    """Fragment files concatenated into the system prompt, in order"""
+
+    prompt_fragment_separator: str = DEFAULT_FRAGMENT_SEPARATOR
+    """Text inserted between prompt fragments"""
+
+    prompt_lint: bool = False
+    """Run heuristic checks on the system prompt and warn before rendering"""
+
+    prompt_lint_strict: bool = False
+    """Fail initialization when prompt lint reports warnings (implies prompt_lint)"""
+
+    layout: str = "standalone"
+    """Project layout for Go templates: standalone or monorepo (services/<name>/)"""
+
+    workspace: str = "go.work"
+    """Workspace file (relative to the target directory) used by the monorepo layout"""
+
+
+def _go_directive(content: str) -> Optional[str]:
+    """Return the version of the go directive in a go.mod or go.work file."""
+    match = re.search(r"^go\s+(\S+)", content, re.M)
+    return match.group(1) if match else None
+
+
+def _max_go_version(a: str, b: Optional[str]) -> str:
+    """Return the higher of two Go versions such as 1.24 and 1.24.4."""
+    if not b:
+        return a
+
+    def key(version: str) -> Tuple[int, ...]:
+        return tuple(int(p) for p in re.findall(r"\d+", version)[:3])
+
+    return b if key(b) > key(a) else a
+
 
 class InitExecutor(BaseExecutor):
     """Executor for initializing agent projects."""
@@ -131,13 +441,33 @@ class InitExecutor(BaseExecutor):
         super().__init__(reporter)
         self.created_files: List[str] = []
 
-    def get_available_templates(self) -> Dict[str, Dict[str, Any]]:
+    def get_available_templates(
+        self,
+        sample_from_git: Optional[str] = None,
+        refresh: bool = False,
+        no_network: bool = False,
+    ) -> Dict[str, Dict[str, Any]]:
         """
         Get available project templates.
 
+        Args:
+            sample_from_git: Git template source (``<url>#<ref>``); when set, the
+                templates of its manifest are returned instead of the built-in ones.
+            refresh: Fetch the git template source again even if it is cached.
+            no_network: Only use a cached checkout of the git template source.
+
         Returns:
             Dictionary of template configurations.
         """
+        if sample_from_git:
+            if refresh and no_network:
+                raise git_templates.TemplateSourceError(
+                    "--refresh cannot be combined with --no-network."
+                )
+            repo_dir = git_templates.fetch_template_repo(
+                sample_from_git, refresh, offline=no_network
+            )
+            return git_templates.load_manifest(repo_dir)
         return TEMPLATES.copy()
 
     def init_project(
@@ -152,6 +482,12 @@ class InitExecutor(BaseExecutor):
         model_api_base: Optional[str] = None,
         model_api_key: Optional[str] = None,
         tools: Optional[str] = None,
+        scaffold_options: Optional[ScaffoldOptions] = None,
+        sample_from_git: Optional[str] = None,
+        refresh: bool = False,
+        no_network: bool = False,
+        init_git: bool = False,
+        explain: bool = False,
     ) -> InitResult:
         """
         Initialize a new agent project from template.
@@ -165,10 +501,22 @@ class InitExecutor(BaseExecutor):
             system_prompt: System prompt (optional).
             model_name: Model name (optional).
             tools: Comma-separated list of tools (optional).
+            scaffold_options: Generation options such as Go features (optional).
+            sample_from_git: Git template source ``<url>#<ref>`` to take the
+                template from instead of the built-in samples (optional).
+            refresh: Fetch the git template source again even if it is cached.
+            no_network: Offline mode: never reach the network and report the
+                steps left to the user in metadata["offline_notes"].
+            init_git: Initialize the project as a git repository with an
+                initial commit; the outcome is in metadata["init_git"].
+            explain: Describe each generated file and enabled feature in
+                metadata["explanation"].
 
         Returns:
             InitResult: Initialization operation result.
         """
+        if scaffold_options is None:
+            scaffold_options = ScaffoldOptions()
         try:
             self.created_files = []
 
@@ -179,18 +527,122 @@ class InitExecutor(BaseExecutor):
                     error_code="INVALID_CONFIG",
                 )
 
-            if template not in TEMPLATES:
+            try:
+                templates = self.get_available_templates(
+                    sample_from_git, refresh, no_network
+                )
+            except git_templates.TemplateSourceError as e:
+                return InitResult(
+                    success=False,
+                    error=str(e),
+                    error_code="TEMPLATE_FETCH_FAILED",
+                )
+
+            if template not in templates:
                 return InitResult(
                     success=False,
-                    error=f"Unknown template '{template}'. Available: {', '.join(TEMPLATES.keys())}",
+                    error=f"Unknown template '{template}'. Available: {', '.join(templates.keys())}",
                     error_code="INVALID_CONFIG",
                 )
 
-            template_info = TEMPLATES[template]
+            template_info = templates[template]
             language = template_info["language"]
             language_version = template_info["language_version"]
 
+            options_error = self._validate_scaffold_options(
+                scaffold_options, template, template_info
+            )
+            if options_error:
+                return InitResult(
+                    success=False,
+                    error=options_error,
+                    error_code="INVALID_CONFIG",
+                )
+
+            if scaffold_options.prompt_fragments:
+                if system_prompt:
+                    return InitResult(
+                        success=False,
+                        error="--prompt-fragments cannot be combined with --system-prompt.",
+                        error_code="INVALID_CONFIG",
+                    )
+                try:
+                    system_prompt = compose_prompt(
+                        scaffold_options.prompt_fragments,
+                        scaffold_options.prompt_fragment_separator,
+                    )
+                except FileNotFoundError as e:
+                    return InitResult(
+                        success=False,
+                        error=str(e),
+                        error_code="FILE_NOT_FOUND",
+                    )
+
+            redact_patterns: List[str] = []
+            if scaffold_options.redact_logs:
+                try:
+                    redact_patterns = load_redact_patterns(scaffold_options.redact_logs)
+                except (FileNotFoundError, ValueError) as e:
+                    error_code = (
+                        "FILE_NOT_FOUND"
+                        if isinstance(e, FileNotFoundError)
+                        else "INVALID_CONFIG"
+                    )
+                    return InitResult(
+                        success=False, error=str(e), error_code=error_code
+                    )
+
+            model_pricing: Dict[str, Any] = {}
+            if scaffold_options.budget_ceiling is not None:
+                try:
+                    model_pricing = load_model_pricing(scaffold_options.budget_pricing)
+                except (FileNotFoundError, ValueError) as e:
+                    error_code = (
+                        "FILE_NOT_FOUND"
+                        if isinstance(e, FileNotFoundError)
+                        else "INVALID_CONFIG"
+                    )
+                    return InitResult(
+                        success=False, error=str(e), error_code=error_code
+                    )
+                fallback = scaffold_options.budget_fallback_model
+                if fallback not in model_pricing["models"]:
+                    return InitResult(
+                        success=False,
+                        error=f"--budget-fallback-model '{fallback}' has no price in the pricing table.",
+                        error_code="INVALID_CONFIG",
+                    )
+
+            output_schema: Dict[str, Any] = {}
+            if scaffold_options.output_schema:
+                try:
+                    output_schema = load_output_schema(scaffold_options.output_schema)
+                except (FileNotFoundError, ValueError) as e:
+                    error_code = (
+                        "FILE_NOT_FOUND"
+                        if isinstance(e, FileNotFoundError)
+                        else "INVALID_CONFIG"
+                    )
+                    return InitResult(
+                        success=False, error=str(e), error_code=error_code
+                    )
+
+            if scaffold_options.prompt_lint or scaffold_options.prompt_lint_strict:
+                lint_error = self._lint_system_prompt(
+                    system_prompt, strict=scaffold_options.prompt_lint_strict
+                )
+                if lint_error:
+                    return InitResult(
+                        success=False,
+                        error=lint_error,
+                        error_code="INVALID_CONFIG",
+                    )
+
             target_dir = Path(directory).resolve()
+            workspace_root = None
+            if scaffold_options.layout == "monorepo":
+                workspace_root = target_dir
+                target_dir = workspace_root / MONOREPO_SERVICES_DIR / project_name
             if not target_dir.exists():
                 target_dir.mkdir(parents=True, exist_ok=True)
                 self.logger.info(f"Created directory: {target_dir}")
@@ -217,9 +669,12 @@ class InitExecutor(BaseExecutor):
             agent_file_path = target_dir / file_name
             config_file_path = target_dir / "agentkit.yaml"
 
-            source_key = template_info.get("file") or template_info.get("filepath")
-            service_dir = Path(__file__).parent
-            source_path = service_dir.parent / "resources" / "samples" / source_key
+            if "source_path" in template_info:
+                source_path = template_info["source_path"]
+            else:
+                source_key = template_info.get("file") or template_info.get("filepath")
+                service_dir = Path(__file__).parent
+                source_path = service_dir.parent / "resources" / "samples" / source_key
 
             if not source_path.exists():
                 return InitResult(
@@ -234,17 +689,46 @@ class InitExecutor(BaseExecutor):
                 system_prompt,
                 model_name,
                 tools,
+                scaffold_options,
+            )
+            render_context["template"] = template
+            render_context["redact_patterns"] = redact_patterns
+            render_context["model_pricing_json"] = json.dumps(model_pricing, indent=2)
+            render_context["output_schema_json"] = json.dumps(
+                output_schema, indent=2, ensure_ascii=False
             )
 
+            try:
+                skipped = self._skipped_template_paths(template_info, render_context)
+            except ValueError as e:
+                return InitResult(
+                    success=False,
+                    error=f"Template '{template}': {e}",
+                    error_code="INVALID_CONFIG",
+                )
+
             if source_path.is_dir():
                 self._copy_template_directory(
-                    source_path, target_dir, language, render_context
+                    source_path, target_dir, language, render_context, skipped
                 )
             else:
                 self._copy_template_file(
                     source_path, agent_file_path, language, render_context
                 )
 
+            if template_info.get("go_features"):
+                self._render_go_feature_files(
+                    target_dir, render_context, scaffold_options
+                )
+
+            if workspace_root is not None:
+                self._apply_monorepo_layout(
+                    workspace_root,
+                    target_dir,
+                    language_version,
+                    scaffold_options.workspace,
+                )
+
             self._create_dependencies_file(
                 dependencies_file_path,
                 language,
@@ -254,6 +738,9 @@ class InitExecutor(BaseExecutor):
                 target_dir,
             )
 
+            if template_info.get("go_features"):
+                self._add_feature_go_requirements(target_dir, scaffold_options)
+
             if language == "Golang":
                 if (target_dir / "build.sh").exists():
                     entry_point_name = "build.sh"
@@ -288,18 +775,36 @@ class InitExecutor(BaseExecutor):
 
             self._create_dockerignore(target_dir)
 
+            metadata = {
+                "language": language,
+                "language_version": language_version,
+                "entry_point": entry_point_name,
+                "template_name": template_info["name"],
+            }
+            if no_network:
+                metadata["offline_notes"] = self._offline_notes(
+                    scaffold_options, sample_from_git
+                )
+            if init_git:
+                metadata["init_git"] = self._init_git_repository(
+                    target_dir, language, project_name, bool(model_api_key)
+                )
+            if explain:
+                metadata["explanation"] = init_explain.explain_project(
+                    self.created_files,
+                    language,
+                    template_info["name"],
+                    entry_point_name,
+                    scaffold_options,
+                )
+
             return InitResult(
                 success=True,
                 project_name=project_name,
                 template=template,
                 project_path=str(target_dir),
                 created_files=self.created_files,
-                metadata={
-                    "language": language,
-                    "language_version": language_version,
-                    "entry_point": entry_point_name,
-                    "template_name": template_info["name"],
-                },
+                metadata=metadata,
             )
 
         except Exception as e:
@@ -319,9 +824,29 @@ class InitExecutor(BaseExecutor):
         system_prompt: Optional[str],
         model_name: Optional[str],
         tools: Optional[str],
+        scaffold_options: Optional[ScaffoldOptions] = None,
     ) -> Dict[str, Any]:
         """Build template rendering context."""
         render_context = {}
+        if scaffold_options is not None:
+            render_context.update(asdict(scaffold_options))
+            render_context["go_features"] = [
+                feature.name
+                for feature in go_features.enabled_features(scaffold_options)
+            ]
+            render_context["gateway"] = "gateway" in render_context["go_features"]
+            render_context["context_header_values"] = (
+                go_features.context_header_values(scaffold_options)
+            )
+            render_context["localize_language_values"] = (
+                go_features.localize_language_values(scaffold_options)
+            )
+            render_context["response_header_values"] = (
+                go_features.response_header_values(scaffold_options)
+            )
+            render_context["attachment_type_values"] = (
+                go_features.attachment_type_values(scaffold_options)
+            )
         if agent_name is not None:
             render_context["agent_name"] = agent_name
         if description is not None:
@@ -335,21 +860,128 @@ class InitExecutor(BaseExecutor):
             render_context["tools"] = tools_list
         return render_context
 
+    def _offline_notes(
+        self, scaffold_options: ScaffoldOptions, sample_from_git: Optional[str]
+    ) -> List[str]:
+        """Describe what --no-network skipped or took from local sources."""
+        notes = []
+        if sample_from_git:
+            notes.append(f"Used the cached checkout of {sample_from_git}.")
+        for package in scaffold_options.tool_package or []:
+            notes.append(
+                f"Skipped go get {package}: provide its module with a replace "
+                "directive or a vendor directory."
+            )
+        return notes
+
+    def _validate_scaffold_options(
+        self,
+        scaffold_options: ScaffoldOptions,
+        template: str,
+        template_info: Dict[str, Any],
+    ) -> Optional[str]:
+        """Validate scaffold options against the selected template."""
+        if scaffold_options.layout not in PROJECT_LAYOUTS:
+            return (
+                f"Invalid --layout '{scaffold_options.layout}'. "
+                f"Must be one of: {', '.join(PROJECT_LAYOUTS)}."
+            )
+        if scaffold_options.layout == "monorepo":
+            if template_info["language"] != "Golang":
+                return f"Template '{template}' does not support --layout monorepo (Go templates only)"
+            if not scaffold_options.workspace or Path(
+                scaffold_options.workspace
+            ).is_absolute():
+                return "--workspace must be a path relative to the target directory"
+        if not template_info.get("go_features"):
+            defaults = ScaffoldOptions()
+            feature_options = set(go_features.feature_option_names())
+            changed = [
+                f.name
+                for f in fields(ScaffoldOptions)
+                if f.name in feature_options
+                and getattr(scaffold_options, f.name) != getattr(defaults, f.name)
+            ]
+            if changed:
+                flags = ", ".join(f"--{name.replace('_', '-')}" for name in changed)
+                return f"Template '{template}' does not support Go feature options: {flags}"
+            return None
+        return go_features.validate_options(
+            scaffold_options
+        ) or go_features.unsupported_feature_error(scaffold_options, template)
+
+    def _lint_system_prompt(
+        self, system_prompt: Optional[str], strict: bool = False
+    ) -> Optional[str]:
+        """
+        Lint the system prompt and report warnings.
+
+        Returns:
+            An error message in strict mode when warnings were found, otherwise None.
+        """
+        if not system_prompt:
+            self.reporter.info("Prompt lint skipped: no system prompt provided")
+            return None
+
+        warnings = lint_prompt(system_prompt)
+        for warning in warnings:
+            self.reporter.warning(f"Prompt lint: {warning}")
+        if not warnings:
+            self.reporter.success("Prompt lint passed")
+            return None
+        if strict:
+            return f"Prompt lint found {len(warnings)} issue(s) (--prompt-lint-strict)"
+        return None
+
+    def _skipped_template_paths(
+        self, template_info: Dict[str, Any], render_context: Dict[str, Any]
+    ) -> Set[str]:
+        """Return the template paths whose manifest condition is false."""
+        conditions = template_info.get("conditions")
+        if not conditions:
+            return set()
+        import jinja2
+        from jinja2.sandbox import SandboxedEnvironment
+
+        # Manifests can come from --sample-from-git, so conditions must not
+        # reach Python internals.
+        env = SandboxedEnvironment()
+        skipped = set()
+        for rel, condition in conditions.items():
+            try:
+                selected = env.compile_expression(condition)(**render_context)
+            except jinja2.TemplateError as e:
+                raise ValueError(f"invalid condition for {rel}: {e}")
+            if not selected:
+                skipped.add(rel)
+        return skipped
+
     def _copy_template_directory(
         self,
         source_path: Path,
         target_dir: Path,
         language: str,
         render_context: Dict[str, Any],
+        skipped: Optional[Set[str]] = None,
     ):
-        """Copy template directory contents."""
+        """Copy template directory contents, leaving out skipped paths."""
+        skipped = skipped or set()
+
+        def ignore(directory: str, names: List[str]) -> List[str]:
+            rel = Path(directory).relative_to(source_path)
+            return [name for name in names if (rel / name).as_posix() in skipped]
+
+        for rel in sorted(skipped):
+            self.logger.info(f"Skipped by template condition: {rel}")
         for item in source_path.iterdir():
+            if item.name == ".git" or item.name in skipped:
+                continue
             dest = target_dir / item.name
             if dest.exists():
                 self.logger.info(f"Skipped existing: {dest}")
                 continue
             if item.is_dir():
-                shutil.copytree(item, dest)
+                shutil.copytree(item, dest, ignore=ignore)
             else:
                 shutil.copy2(item, dest)
             self.created_files.append(item.name)
@@ -371,14 +1003,20 @@ class InitExecutor(BaseExecutor):
 
         if language.lower() == "python":
             try:
-                import jinja2
+                from jinja2.sandbox import SandboxedEnvironment
             except ImportError:
                 raise ImportError(
                     "Jinja2 is required. Please install with 'pip install Jinja2'"
                 )
 
+            # The sample can come from --sample-from-git.
+            env = SandboxedEnvironment()
+            # Render Python strings as Python string literals.
+            env.filters["py_string"] = lambda value: repr(
+                "" if value is None else str(value)
+            )
             template_content = source_path.read_text(encoding="utf-8")
-            template = jinja2.Template(template_content)
+            template = env.from_string(template_content)
             rendered_content = template.render(**render_context)
             agent_file_path.write_text(rendered_content, encoding="utf-8")
             self.created_files.append(agent_file_path.name)
@@ -389,23 +1027,35 @@ class InitExecutor(BaseExecutor):
             if agent_file_path.name == "agent.go":
                 self._render_go_agent_templates(agent_file_path.parent, render_context)
 
+    def _get_go_template_env(self):
+        """Create the sandboxed Jinja2 environment used for Go templates."""
+        from jinja2.sandbox import SandboxedEnvironment
+
+        env = SandboxedEnvironment(keep_trailing_newline=True)
+        # Render Python strings as Go interpreted string literals.
+        env.filters["go_string"] = lambda value: json.dumps(
+            "" if value is None else str(value), ensure_ascii=False
+        )
+        env.filters["go_duration"] = go_features.go_duration
+        return env
+
     def _render_go_agent_templates(
         self, target_dir: Path, render_context: Dict[str, Any]
     ):
-        """Render Go template files (agent.go)."""
+        """Render Go template files (agent.go, main.go)."""
         try:
-            import jinja2
+            env = self._get_go_template_env()
         except ImportError:
             self.logger.warning("Jinja2 not available, skipping Go template rendering")
             return
 
         for root, _, files in os.walk(target_dir):
             for fname in files:
-                if fname == "agent.go":
+                if fname in ("agent.go", "main.go"):
                     p = Path(root) / fname
                     try:
                         template_content = p.read_text(encoding="utf-8")
-                        template = jinja2.Template(template_content)
+                        template = env.from_string(template_content)
                         rendered_content = template.render(**render_context)
                         p.write_text(rendered_content, encoding="utf-8")
                         self.logger.info(
@@ -414,6 +1064,156 @@ class InitExecutor(BaseExecutor):
                     except Exception as e:
                         self.logger.warning(f"Failed to render {p}: {e}")
 
+    def _render_go_feature_files(
+        self,
+        target_dir: Path,
+        render_context: Dict[str, Any],
+        scaffold_options: ScaffoldOptions,
+    ):
+        """Render the Go files of all enabled features into the project."""
+        features = go_features.enabled_features(scaffold_options)
+        if not features:
+            return
+
+        try:
+            env = self._get_go_template_env()
+        except ImportError:
+            raise ImportError(
+                "Jinja2 is required. Please install with 'pip install Jinja2'"
+            )
+
+        file_names = [go_features.FEATURES_ENTRY_FILE]
+        for feature in features:
+            file_names.extend(feature.files)
+
+        for file_name in file_names:
+            dest = target_dir / file_name
+            if dest.exists():
+                self.logger.info(f"Skipped existing: {dest}")
+                continue
+            source = go_features.FEATURES_TEMPLATE_DIR / f"{file_name}.j2"
+            template = env.from_string(source.read_text(encoding="utf-8"))
+            dest.parent.mkdir(parents=True, exist_ok=True)
+            dest.write_text(template.render(**render_context), encoding="utf-8")
+            self.created_files.append(file_name)
+            self.logger.info(f"Rendered Go feature file: {file_name}")
+
+    def _add_feature_go_requirements(
+        self, target_dir: Path, scaffold_options: ScaffoldOptions
+    ):
+        """Require the modules of enabled features in go.mod and go.sum.
+
+        The go.sum lines are bundled, so the project builds without go mod tidy.
+        """
+        requires = go_features.go_requirements(scaffold_options)
+        go_mod = target_dir / "go.mod"
+        if not requires or not go_mod.is_file():
+            return
+        content = go_mod.read_text(encoding="utf-8")
+        missing = [
+            (path, version, indirect)
+            for path, version, indirect in requires
+            if not re.search(rf"^\s*(require\s+)?{re.escape(path)}\s", content, re.M)
+        ]
+        if not missing:
+            return
+        lines = [
+            f"\t{path} {version}" + (" // indirect" if indirect else "")
+            for path, version, indirect in missing
+        ]
+        block = "require (\n" + "\n".join(lines) + "\n)\n"
+        go_mod.write_text(content.rstrip("\n") + "\n\n" + block, encoding="utf-8")
+
+        versions = {f"{path} {version}" for path, version, _ in missing}
+        bundled = go_features.FEATURES_GO_SUM.read_text(encoding="utf-8")
+        sums = "".join(
+            f"{line}\n"
+            for line in bundled.splitlines()
+            if " ".join(line.split()[:2]).removesuffix("/go.mod") in versions
+        )
+        go_sum = target_dir / "go.sum"
+        if go_sum.exists():
+            existing = go_sum.read_text(encoding="utf-8")
+            if existing and not existing.endswith("\n"):
+                existing += "\n"
+            go_sum.write_text(existing + sums, encoding="utf-8")
+        else:
+            go_sum.write_text(sums, encoding="utf-8")
+            self.created_files.append("go.sum")
+        self.logger.info(
+            "Added to go.mod: " + ", ".join(path for path, _, _ in missing)
+        )
+
+    def _apply_monorepo_layout(
+        self,
+        workspace_root: Path,
+        target_dir: Path,
+        language_version: str,
+        workspace: str,
+    ):
+        """
+        Fit a generated Go project into a monorepo workspace.
+
+        The sample's standalone module path is replaced by one relative to the
+        workspace root, and the module is registered in the go.work file. The
+        project keeps its own go.mod: a go.work ``use`` directive can only name
+        a directory that holds one, and it pins the agent's VeADK dependencies
+        without touching the root module.
+        """
+        member_path = target_dir.relative_to(workspace_root).as_posix()
+
+        root_module = None
+        root_go_mod = workspace_root / "go.mod"
+        if root_go_mod.exists():
+            match = re.search(
+                r"^module\s+(\S+)", root_go_mod.read_text(encoding="utf-8"), re.M
+            )
+            if match:
+                root_module = match.group(1)
+        module_path = f"{root_module}/{member_path}" if root_module else member_path
+
+        go_mod = target_dir / "go.mod"
+        member_go = language_version or "1.24"
+        if go_mod.exists():
+            content = go_mod.read_text(encoding="utf-8")
+            member_go = _max_go_version(member_go, _go_directive(content))
+        if "go.mod" in self.created_files:
+            content = re.sub(
+                r"^module\s+\S+", f"module {module_path}", content, count=1, flags=re.M
+            )
+            go_mod.write_text(content, encoding="utf-8")
+            self.logger.info(f"Set module path to {module_path}")
+
+        work_file = workspace_root / workspace
+        use_line = Path(os.path.relpath(target_dir, work_file.parent)).as_posix()
+        if not use_line.startswith("."):
+            use_line = f"./{use_line}"
+        if work_file.exists():
+            content = work_file.read_text(encoding="utf-8")
+            if re.search(rf"^\s*(use\s+)?{re.escape(use_line)}\s*$", content, re.M):
+                self.logger.info(f"{workspace} already uses {use_line}, skipping")
+                return
+            # Like go work use, raise the workspace go version to what the new
+            # member requires; the toolchain refuses the workspace otherwise.
+            work_go = _go_directive(content)
+            if work_go and _max_go_version(work_go, member_go) != work_go:
+                content = re.sub(
+                    r"^go\s+\S+", f"go {member_go}", content, count=1, flags=re.M
+                )
+                self.logger.info(f"Raised {workspace} go version to {member_go}")
+            block = re.search(r"^use\s*\(\n(.*?)^\)", content, re.M | re.S)
+            if block:
+                insert_at = block.end(1)
+                content = content[:insert_at] + f"\t{use_line}\n" + content[insert_at:]
+            else:
+                content = content.rstrip("\n") + f"\n\nuse {use_line}\n"
+        else:
+            content = f"go {member_go}\n\nuse {use_line}\n"
+        work_file.parent.mkdir(parents=True, exist_ok=True)
+        work_file.write_text(content, encoding="utf-8")
+        self.created_files.append(os.path.relpath(work_file, target_dir))
+        self.logger.info(f"Added {use_line} to {workspace}")
+
     def _create_python_requirements(
         self,
         dependencies_file_path: Path,
@@ -640,6 +1440,23 @@ class InitExecutor(BaseExecutor):
         if create_dockerignore_file(str(target_dir)):
             self.created_files.append(".dockerignore")
 
+    def _init_git_repository(
+        self,
+        target_dir: Path,
+        language: str,
+        project_name: str,
+        ignore_config: bool = False,
+    ) -> str:
+        """Run --init-git and return what happened; skips are not errors."""
+        reason = git_init.skip_reason(target_dir)
+        if reason:
+            self.logger.info(reason)
+            return reason
+        entries = git_init.gitignore_entries(language, project_name, ignore_config)
+        if git_init.write_gitignore(target_dir, entries):
+            self.created_files.append(".gitignore")
+        return git_init.create_initial_commit(target_dir, self.created_files)
+
     def init_from_agent_file(
         self,
         project_name: str,
@@ -647,6 +1464,10 @@ class InitExecutor(BaseExecutor):
         agent_var_name: Optional[str] = None,
         wrapper_type: str = "basic",
         directory: str = ".",
+        no_network: bool = False,
+        init_git: bool = False,
+        explain: bool = False,
+        scaffold_options: Optional[ScaffoldOptions] = None,
     ) -> InitResult:
         """
         Initialize a project by wrapping an existing Agent definition file.
@@ -657,6 +1478,14 @@ class InitExecutor(BaseExecutor):
             agent_var_name: Optional explicit Agent variable name.
             wrapper_type: Type of wrapper to generate (basic or stream).
             directory: Target directory for the project.
+            no_network: Offline mode. Wrapping never reaches the network; the
+                notes in metadata["offline_notes"] say so.
+            init_git: Initialize the project as a git repository with an
+                initial commit; the outcome is in metadata["init_git"].
+            explain: Describe each generated file in metadata["explanation"].
+            scaffold_options: Template generation options as passed on the
+                command line. They have no effect on a wrapper, so any option
+                that differs from its default is rejected.
 
         Returns:
             InitResult: Initialization operation result.
@@ -664,6 +1493,23 @@ class InitExecutor(BaseExecutor):
         try:
             self.created_files = []
 
+            if scaffold_options is not None:
+                defaults = ScaffoldOptions()
+                changed = [
+                    f.name
+                    for f in fields(ScaffoldOptions)
+                    if getattr(scaffold_options, f.name) != getattr(defaults, f.name)
+                ]
+                if changed:
+                    flags = ", ".join(
+                        f"--{name.replace('_', '-')}" for name in changed
+                    )
+                    return InitResult(
+                        success=False,
+                        error=f"Template options cannot be combined with --from-agent: {flags}",
+                        error_code="INVALID_CONFIG",
+                    )
+
             if not re.match(r"^[a-zA-Z0-9_-]+$", project_name):
                 return InitResult(
                     success=False,
@@ -735,21 +1581,44 @@ class InitExecutor(BaseExecutor):
 
             self._create_dockerignore(target_dir)
 
+            metadata = {
+                "language": "Python",
+                "language_version": "3.12",
+                "entry_point": wrapper_file_path.name,
+                "template_name": f"Agent Wrapper ({wrapper_type.title()})",
+                "agent_file": agent_info.file_name,
+                "agent_var": agent_info.agent_var_name,
+                "wrapper_type": wrapper_type,
+            }
+            if no_network:
+                metadata["offline_notes"] = [
+                    "Wrapping an agent file needs no network access."
+                ]
+            if init_git:
+                metadata["init_git"] = self._init_git_repository(
+                    target_dir, "Python", project_name
+                )
+            if explain:
+                metadata["explanation"] = init_explain.explain_project(
+                    self.created_files,
+                    "Python",
+                    metadata["template_name"],
+                    wrapper_file_path.name,
+                    ScaffoldOptions(),
+                    file_roles={
+                        agent_info.file_name: "Your agent definition, copied "
+                        f"unchanged; the wrapper imports {agent_info.agent_var_name} "
+                        "from it."
+                    },
+                )
+
             return InitResult(
                 success=True,
                 project_name=project_name,
                 template=f"wrapper_{wrapper_type}",
                 project_path=str(target_dir),
                 created_files=self.created_files,
-                metadata={
-                    "language": "Python",
-                    "language_version": "3.12",
-                    "entry_point": wrapper_file_path.name,
-                    "template_name": f"Agent Wrapper ({wrapper_type.title()})",
-                    "agent_file": agent_info.file_name,
-                    "agent_var": agent_info.agent_var_name,
-                    "wrapper_type": wrapper_type,
-                },
+                metadata=metadata,
             )
 
         except Exception as e:
@@ -762,6 +1631,248 @@ class InitExecutor(BaseExecutor):
                 error_code=error_info["error_code"],
             )
 
+    def scaffold_tool(
+        self,
+        name: str,
+        module: Optional[str] = None,
+        directory: str = ".",
+        description: Optional[str] = None,
+        no_network: bool = False,
+    ) -> InitResult:
+        """
+        Generate a standalone Go package for one tool, importable by any agent.
+
+        The package is written to ``<directory>/<package>/``, where ``directory``
+        is the module root. A go.mod is created there if it does not exist yet.
+
+        Args:
+            name: Tool name the model calls (e.g. geocode or get_weather).
+            module: Go module path of the module root; defaults to the module
+                declared by an existing go.mod.
+            directory: Module root directory.
+            description: Tool description shown to the model.
+            no_network: Pin a new go.mod and go.sum to the bundled dependency
+                versions so the module builds without go mod tidy.
+
+        Returns:
+            InitResult: project_path is the package directory and
+            metadata["import_path"] the path to pass to --tool-package.
+        """
+        try:
+            self.created_files = []
+
+            if not re.fullmatch(r"[a-z][a-z0-9_]*", name):
+                return InitResult(
+                    success=False,
+                    error=f"Invalid tool name '{name}'. Use lowercase letters, digits "
+                    "and underscores, starting with a letter.",
+                    error_code="INVALID_CONFIG",
+                )
+            package = name.replace("_", "")
+            if package in go_features.RESERVED_TOOL_PACKAGE_NAMES:
+                return InitResult(
+                    success=False,
+                    error=f"Tool name '{name}' is reserved.",
+                    error_code="INVALID_CONFIG",
+                )
+
+            module_dir = Path(directory).resolve()
+            go_mod = module_dir / "go.mod"
+            declared_module = None
+            if go_mod.is_file():
+                match = re.search(
+                    r"^module\s+(\S+)", go_mod.read_text(encoding="utf-8"), re.M
+                )
+                declared_module = match.group(1).strip('"') if match else None
+            module = module or declared_module
+            if not module:
+                return InitResult(
+                    success=False,
+                    error=f"--module is required: no go.mod found in {module_dir}",
+                    error_code="INVALID_CONFIG",
+                )
+            if not re.fullmatch(go_features.GO_IMPORT_PATH_PATTERN, module):
+                return InitResult(
+                    success=False,
+                    error=f"Invalid --module '{module}'. "
+                    "Use a Go module path, e.g. github.com/org/tools.",
+                    error_code="INVALID_CONFIG",
+                )
+            if declared_module and declared_module != module:
+                return InitResult(
+                    success=False,
+                    error=f"{go_mod} declares module {declared_module}, not {module}",
+                    error_code="INVALID_CONFIG",
+                )
+
+            package_dir = module_dir / package
+            if package_dir.exists():
+                return InitResult(
+                    success=False,
+                    error=f"'{package_dir}' already exists",
+                    error_code="INVALID_CONFIG",
+                )
+
+            import_path = f"{module}/{package}"
+            render_context = {
+                "tool_name": name,
+                "package": package,
+                "module": module,
+                "import_path": import_path,
+                "description": description
+                or f"TODO: describe what {name} does and when to call it.",
+            }
+            file_names = {
+                "tool.go": f"{package}/{package}.go",
+                "tool_test.go": f"{package}/{package}_test.go",
+            }
+            pin_module = no_network and not go_mod.exists()
+            if not go_mod.exists() and not pin_module:
+                file_names["go.mod"] = "go.mod"
+
+            env = self._get_go_template_env()
+            for source_name, dest_name in file_names.items():
+                source = go_features.TOOL_PACKAGE_TEMPLATE_DIR / f"{source_name}.j2"
+                template = env.from_string(source.read_text(encoding="utf-8"))
+                dest = module_dir / dest_name
+                dest.parent.mkdir(parents=True, exist_ok=True)
+                dest.write_text(template.render(**render_context), encoding="utf-8")
+                self.created_files.append(dest_name)
+                self.logger.info(f"Rendered tool package file: {dest_name}")
+            if pin_module:
+                self._write_pinned_go_module(module_dir, module)
+
+            return InitResult(
+                success=True,
+                project_name=name,
+                template="tool_package",
+                project_path=str(package_dir),
+                created_files=self.created_files,
+                metadata={
+                    "language": "Golang",
+                    "module": module,
+                    "import_path": import_path,
+                    "pinned": pin_module,
+                },
+            )
+
+        except Exception as e:
+            error_info = self._handle_exception("Tool scaffolding", e)
+            return InitResult(
+                success=False,
+                project_name=name,
+                template="tool_package",
+                error=error_info["error"],
+                error_code=error_info["error_code"],
+            )
+
+    def export_vars_schema(
+        self,
+        sample: str,
+        sample_from_git: Optional[str] = None,
+        refresh: bool = False,
+        no_network: bool = False,
+    ) -> InitResult:
+        """
+        Describe the variables of an init sample as a JSON Schema.
+
+        The schema lists the variables the sample is rendered with and the
+        scaffold options its template accepts, with their types, defaults,
+        allowed values and descriptions, so that tools can build a form for
+        agentkit init or validate a set of inputs before running it.
+
+        Args:
+            sample: Template name (e.g. basic_go) or the sample it is rendered
+                from as named in the manifest (e.g. veadk_go_basic or basic.py).
+            sample_from_git: Git template source ``<url>#<ref>`` to take the
+                manifest from instead of the built-in samples (optional).
+            refresh: Fetch the git template source again even if it is cached.
+            no_network: Only use a cached checkout of the git template source.
+
+        Returns:
+            InitResult: metadata["schema"] holds the JSON Schema.
+        """
+        try:
+            try:
+                templates = self.get_available_templates(
+                    sample_from_git, refresh, no_network
+                )
+            except git_templates.TemplateSourceError as e:
+                return InitResult(
+                    success=False,
+                    error=str(e),
+                    error_code="TEMPLATE_FETCH_FAILED",
+                )
+
+            template = self._resolve_sample(sample, templates)
+            if template is None:
+                return InitResult(
+                    success=False,
+                    error=f"Unknown sample '{sample}'. Available: {', '.join(templates.keys())}",
+                    error_code="INVALID_CONFIG",
+                )
+            template_info = templates[template]
+
+            option_names = ["prompt_fragments", "prompt_fragment_separator"]
+            if template_info["language"] == "Golang":
+                option_names += ["layout", "workspace"]
+            if template_info.get("go_features"):
+                option_names += go_features.feature_option_names(template)
+            choices = dict(go_features.OPTION_CHOICES, layout=PROJECT_LAYOUTS)
+
+            properties = copy.deepcopy(vars_schema.SAMPLE_VARIABLES)
+            properties.update(
+                vars_schema.dataclass_properties(
+                    ScaffoldOptions, option_names, choices
+                )
+            )
+            schema = {
+                "$schema": vars_schema.JSON_SCHEMA_DIALECT,
+                "title": template_info.get("name", template),
+                "description": template_info.get("description", ""),
+                "type": "object",
+                "properties": properties,
+                "required": list(vars_schema.REQUIRED_VARIABLES),
+                "additionalProperties": False,
+                "x-agentkit-template": template,
+            }
+            return InitResult(
+                success=True,
+                template=template,
+                metadata={"language": template_info["language"], "schema": schema},
+            )
+
+        except Exception as e:
+            error_info = self._handle_exception("Schema export", e)
+            return InitResult(
+                success=False,
+                template=sample,
+                error=error_info["error"],
+                error_code=error_info["error_code"],
+            )
+
+    def _resolve_sample(
+        self, sample: str, templates: Dict[str, Dict[str, Any]]
+    ) -> Optional[str]:
+        """Return the template rendered from a sample, matched by name or path."""
+        if sample in templates:
+            return sample
+        for name, info in templates.items():
+            source = info.get("source_path") or info.get("file") or info.get("filepath")
+            if source and sample in (Path(source).name, Path(source).stem):
+                return name
+        return None
+
+    def _write_pinned_go_module(self, module_dir: Path, module: str):
+        """Create go.mod and go.sum from the bundled dependency pins."""
+        pins_dir = go_features.BUNDLED_GO_PINS_DIR
+        pinned = (pins_dir / "go.mod").read_text(encoding="utf-8")
+        go_mod = re.sub(r"^module\s+\S+", f"module {module}", pinned, flags=re.M)
+        (module_dir / "go.mod").write_text(go_mod, encoding="utf-8")
+        shutil.copy2(pins_dir / "go.sum", module_dir / "go.sum")
+        self.created_files.extend(["go.mod", "go.sum"])
+        self.logger.info("Pinned go.mod and go.sum to the bundled versions")
+
     def _copy_agent_file(self, agent_info: AgentFileInfo, target_dir: Path):
         """Copy user's Agent file to target directory."""
         source_path = Path(agent_info.file_path)
@@ -32,7 +32,8 @@ app_name = "simple_streamable_app"
 
 agent_name = "{{ agent_name | default('Agent') }}"
 {% if description %}description = "{{ description }}" {% else %}description = DEFAULT_DESCRIPTION {% endif %}
-{% if system_prompt %}system_prompt = "{{ system_prompt }}" {% else %}system_prompt = DEFAULT_INSTRUCTION {% endif %}
+{% if prompt_fragments and system_prompt %}# Composed from prompt fragments: {{ prompt_fragments | join(", ") }}
+system_prompt = {{ system_prompt | py_string }} {% elif system_prompt %}system_prompt = "{{ system_prompt }}" {% else %}system_prompt = DEFAULT_INSTRUCTION {% endif %}
 {% if model_name %}model_name = "{{ model_name }}"{% endif %}
 
 tools = []
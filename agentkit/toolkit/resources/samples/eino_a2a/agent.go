@@ -29,7 +29,8 @@ func buildSimpleAgent(ctx context.Context) (adk.Agent, error) {
 	{% if description %}description = `{{ description }}`{% else %}description = DEFAULT_DESCRIPTION{% endif %}
 
 	var instruction string
-	{% if system_prompt %}instruction = `{{ system_prompt }}`{% else %}instruction = DEFAULT_INSTRUCTION{% endif %}
+	{% if prompt_fragments %}// Composed from prompt fragments: {{ prompt_fragments | join(", ") }}
+	{% endif %}{% if system_prompt %}instruction = {{ system_prompt | go_string }}{% else %}instruction = DEFAULT_INSTRUCTION{% endif %}
 	// // ========================================================
 
 	// Resolve BaseURL from env or use default
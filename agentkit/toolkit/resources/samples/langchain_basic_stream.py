@@ -39,7 +39,8 @@ model_name = os.getenv("MODEL_AGENT_NAME", DEFAULT_ARK_MODEL_NAME)
 model_base_url = os.getenv("MODEL_AGENT_BASE_URL", ARK_BASE_URL)
 model_api_key = os.getenv("MODEL_AGENT_API_KEY")
 
-{% if system_prompt %}system_prompt = "{{ system_prompt }}" {% else %}system_prompt = os.getenv("MODEL_AGENT_SYSTEM_PROMPT", DEFAULT_SYSTEM_PROMPT) {% endif %}
+{% if prompt_fragments and system_prompt %}# Composed from prompt fragments: {{ prompt_fragments | join(", ") }}
+system_prompt = {{ system_prompt | py_string }} {% elif system_prompt %}system_prompt = "{{ system_prompt }}" {% else %}system_prompt = os.getenv("MODEL_AGENT_SYSTEM_PROMPT", DEFAULT_SYSTEM_PROMPT) {% endif %}
 
 
 if model_api_key is None or model_api_key.strip() == "":
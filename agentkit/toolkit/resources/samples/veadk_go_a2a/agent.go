@@ -26,7 +26,8 @@ func buildSampleAgent() (agent.Agent, error) {
 	{% if description %}description = `{{ description }}`{% else %}description = ""{% endif %}
 
 	var instruction string
-	{% if system_prompt %}instruction = `{{ system_prompt }}`{% else %}instruction = ""{% endif %}
+	{% if prompt_fragments %}// Composed from prompt fragments: {{ prompt_fragments | join(", ") }}
+	{% endif %}{% if system_prompt %}instruction = {{ system_prompt | go_string }}{% else %}instruction = ""{% endif %}
 
 	cfg := &veagent.Config{
 		Config: llmagent.Config{
@@ -42,5 +43,17 @@ func buildSampleAgent() (agent.Agent, error) {
 			},
 		},
 	}
+	{%- if stop %}
+	cfg.ModelExtraConfig["stop"] = []string{ {%- for seq in stop %}{{ seq | go_string }}{% if not loop.last %}, {% endif %}{% endfor %}}
+	{%- endif %}
+	{%- if frequency_penalty is not none %}
+	cfg.ModelExtraConfig["frequency_penalty"] = {{ frequency_penalty }}
+	{%- endif %}
+	{%- if presence_penalty is not none %}
+	cfg.ModelExtraConfig["presence_penalty"] = {{ presence_penalty }}
+	{%- endif %}
+	{%- if go_features %}
+	applyFeatures(cfg)
+	{%- endif %}
 	return veagent.New(cfg)
 }
@@ -34,14 +34,35 @@ func main() {
 	}
 
 	a2aApp := a2a_app.NewAgentkitA2AServerApp(apps.ApiConfig{
-		Port:         8000,
+		Port:         {% if gateway %}appPort{% else %}8000{% endif %},
 		WriteTimeout: 120 * time.Second,
 		ReadTimeout:  120 * time.Second,
 		IdleTimeout:  600 * time.Second,
 	})
+	{%- if gateway %}
+
+	go func() {
+		if err := runGateway(ctx); err != nil {
+			log.Fatalf("Gateway failed: %v", err)
+		}
+	}()
+	{%- endif %}
+	{%- if pprof %}
+
+	go func() {
+		if err := runPprof(ctx); err != nil {
+			log.Printf("pprof server failed: %v", err)
+		}
+	}()
+	{%- endif %}
 
 	err = a2aApp.Run(ctx, &apps.RunConfig{
+{%- if session_ttl %}
+		SessionService: expiringSessionService(),
+		AgentLoader:    agent.NewSingleLoader(a),
+{%- else %}
 		AgentLoader: agent.NewSingleLoader(a),
+{%- endif %}
 	})
 	if err != nil {
 		fmt.Printf("Run failed: %v", err)
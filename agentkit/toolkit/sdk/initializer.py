@@ -16,7 +16,7 @@
 
 from typing import Optional, Dict, Any
 
-from ..executors import InitExecutor
+from ..executors import InitExecutor, ScaffoldOptions
 from ..reporter import SilentReporter, Reporter
 from ..models import InitResult
 
@@ -31,6 +31,7 @@ def init_project(
     model_name: Optional[str] = None,
     tools: Optional[str] = None,
     reporter: Optional[Reporter] = None,
+    scaffold_options: Optional[ScaffoldOptions] = None,
 ) -> InitResult:
     """
     Initialize a new agent project from template.
@@ -57,6 +58,8 @@ def init_project(
         reporter: Optional Reporter for progress/log output. If None, uses
             SilentReporter (no console output). Advanced users can pass
             LoggingReporter or a custom Reporter implementation.
+        scaffold_options: Code generation options for Go templates such as
+            ``basic_go`` (optional). See ScaffoldOptions for available fields.
 
     Returns:
         InitResult: Initialization result containing:
@@ -108,6 +111,7 @@ def init_project(
         system_prompt=system_prompt,
         model_name=model_name,
         tools=tools,
+        scaffold_options=scaffold_options,
     )
 
 
@@ -0,0 +1,156 @@
+# Copyright (c) 2026 Beijing Volcano Engine Technology Co., Ltd. and/or its affiliates.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+"""Git init - Initialize a generated project as a git repository."""
+
+import shutil
+import subprocess
+from pathlib import Path
+from typing import List, Optional
+
+
+INITIAL_COMMIT_MESSAGE = "Initial commit from agentkit init"
+GIT_TIMEOUT_SECONDS = 60
+
+_COMMON_IGNORES = [
+    "# AgentKit local state",
+    ".agentkit/",
+    "",
+    "# Environment files",
+    ".env",
+    ".env.*",
+    "",
+    "# IDE / OS",
+    ".vscode/",
+    ".idea/",
+    ".DS_Store",
+]
+
+_PYTHON_IGNORES = [
+    "# Python",
+    "__pycache__/",
+    "*.py[cod]",
+    ".venv/",
+    "venv/",
+    ".pytest_cache/",
+    "*.egg-info/",
+]
+
+
+def gitignore_entries(
+    language: str, project_name: str, ignore_config: bool = False
+) -> List[str]:
+    """
+    Return the .gitignore lines of a generated project.
+
+    Args:
+        language: Project language (Python or Golang).
+        project_name: Project name; also the binary name of Go builds.
+        ignore_config: Keep agentkit.yaml out of the repository, e.g. because
+            it holds the model API key.
+    """
+    entries = list(_COMMON_IGNORES)
+    if language.lower() == "golang":
+        entries += ["", "# Go build output", f"/{project_name}"]
+    else:
+        entries += [""] + _PYTHON_IGNORES
+    if ignore_config:
+        entries += [
+            "",
+            "# Holds the model API key passed to agentkit init",
+            "agentkit.yaml",
+        ]
+    return entries
+
+
+def skip_reason(target_dir: Path) -> Optional[str]:
+    """Return why --init-git cannot run in target_dir, or None if it can."""
+    if shutil.which("git") is None:
+        return "git is not installed; skipped --init-git."
+    result = _git(target_dir, "rev-parse", "--is-inside-work-tree")
+    if result.returncode == 0:
+        return f"{target_dir} is already in a git repository; skipped --init-git."
+    return None
+
+
+def write_gitignore(target_dir: Path, entries: List[str]) -> bool:
+    """Write .gitignore unless one exists. Returns True if it was created."""
+    path = target_dir / ".gitignore"
+    if path.exists():
+        return False
+    path.write_text("\n".join(entries) + "\n", encoding="utf-8")
+    return True
+
+
+def create_initial_commit(target_dir: Path, paths: List[str]) -> str:
+    """
+    Initialize target_dir as a repository and commit the given files.
+
+    Only the generated files are staged, so unrelated files already in
+    target_dir stay out of the initial commit. Paths outside target_dir or
+    matched by .gitignore are left out.
+
+    Args:
+        target_dir: Project directory.
+        paths: Files created by agentkit init, relative to target_dir.
+
+    Returns:
+        A message saying what was done. A failed commit, e.g. because no git
+        identity is configured, is reported instead of raised.
+    """
+    result = _git(target_dir, "init", "--quiet")
+    if result.returncode != 0:
+        return f"git init failed: {_last_line(result)}"
+    root = target_dir.resolve()
+    paths = [
+        p
+        for p in dict.fromkeys(paths)
+        if (target_dir / p).exists()
+        and (target_dir / p).resolve().is_relative_to(root)
+    ]
+    if paths:
+        ignored = _git(target_dir, "check-ignore", "--", *paths).stdout.splitlines()
+        paths = [p for p in paths if p not in ignored]
+    if not paths:
+        return "Initialized a git repository; no generated files to commit."
+    result = _git(target_dir, "add", "--", *paths)
+    if result.returncode != 0:
+        return f"git add failed: {_last_line(result)}"
+    result = _git(target_dir, "commit", "--quiet", "-m", INITIAL_COMMIT_MESSAGE)
+    if result.returncode != 0:
+        return (
+            "Initialized a git repository, but the initial commit failed: "
+            f"{_last_line(result)}"
+        )
+    return "Initialized a git repository with an initial commit."
+
+
+def _git(cwd: Path, *args: str) -> subprocess.CompletedProcess:
+    try:
+        return subprocess.run(
+            ["git", *args],
+            cwd=cwd,
+            capture_output=True,
+            text=True,
+            timeout=GIT_TIMEOUT_SECONDS,
+        )
+    except subprocess.TimeoutExpired:
+        return subprocess.CompletedProcess(
+            args, 1, stdout="", stderr=f"git {args[0]} timed out."
+        )
+
+
+def _last_line(result: subprocess.CompletedProcess) -> str:
+    detail = (result.stderr or result.stdout or "").strip().splitlines()
+    return detail[-1] if detail else str(result.returncode)
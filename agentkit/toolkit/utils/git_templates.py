@@ -0,0 +1,240 @@
+# Copyright (c) 2026 Beijing Volcano Engine Technology Co., Ltd. and/or its affiliates.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+"""Git template sources - Init templates fetched from a remote git repository.
+
+A template repository has an ``agentkit-templates.yaml`` manifest at its root
+that declares templates in the same shape as the built-in registry::
+
+    templates:
+      support_bot:
+        name: Support Bot
+        language: Python
+        language_version: "3.12"
+        type: Basic App
+        description: Customer support agent
+        file: support_bot.py        # or filepath: <directory>
+        conditions:                 # filepath templates only
+          tools.py: tools           # skipped unless the condition is true
+
+A condition is a Jinja2 expression evaluated with the render variables of the
+template. Files and directories whose condition is false are not copied.
+
+Checkouts are cached per repository and ref under ``~/.agentkit/templates``.
+"""
+
+import hashlib
+import os
+import re
+import shutil
+import subprocess
+import tempfile
+from pathlib import Path
+from typing import Any, Dict, Optional, Tuple
+
+import yaml
+
+from agentkit.toolkit.config.constants import GLOBAL_CONFIG_DIR
+
+
+MANIFEST_FILE = "agentkit-templates.yaml"
+TEMPLATE_CACHE_DIR = GLOBAL_CONFIG_DIR / "templates"
+SUPPORTED_LANGUAGES = {"Python": "3.12", "Golang": "1.24"}
+GIT_TIMEOUT_SECONDS = 120
+
+
+class TemplateSourceError(Exception):
+    """Raised when a git template source cannot be fetched or is invalid."""
+
+
+def parse_git_source(source: str) -> Tuple[str, Optional[str]]:
+    """Split ``<url>#<ref>`` into the repository URL and the optional ref."""
+    url, _, ref = source.partition("#")
+    url, ref = url.strip(), ref.strip()
+    if not url:
+        raise TemplateSourceError(f"Invalid --sample-from-git '{source}'.")
+    return url, ref or None
+
+
+def fetch_template_repo(
+    source: str, refresh: bool = False, offline: bool = False
+) -> Path:
+    """
+    Return a local checkout of a git template source, fetching it if needed.
+
+    Args:
+        source: Repository URL, optionally followed by ``#<branch, tag or commit>``.
+        refresh: Fetch again even if the ref is already cached.
+        offline: Only use the cache and never run git fetch.
+
+    Returns:
+        Path to the checked out working tree.
+    """
+    url, ref = parse_git_source(source)
+    # Hashed rather than sanitized, so that refs such as a/b and a_b do not
+    # share a checkout.
+    repo_key = hashlib.sha256(url.encode("utf-8")).hexdigest()[:16]
+    ref_key = hashlib.sha256((ref or "HEAD").encode("utf-8")).hexdigest()[:16]
+    checkout = TEMPLATE_CACHE_DIR / repo_key / ref_key
+    if checkout.exists() and not refresh:
+        return checkout
+    if offline:
+        raise TemplateSourceError(
+            f"'{source}' is not cached; --no-network only uses templates fetched before."
+        )
+
+    checkout.parent.mkdir(parents=True, exist_ok=True)
+    staging = Path(tempfile.mkdtemp(prefix=f".{ref_key}-", dir=checkout.parent))
+    try:
+        _git(staging, "init", "--quiet")
+        _git(staging, "fetch", "--quiet", "--depth", "1", url, ref or "HEAD")
+        _git(staging, "checkout", "--quiet", "FETCH_HEAD")
+        if checkout.exists():
+            shutil.rmtree(checkout)
+        staging.rename(checkout)
+    finally:
+        shutil.rmtree(staging, ignore_errors=True)
+    return checkout
+
+
+def _git(cwd: Path, *args: str) -> None:
+    try:
+        subprocess.run(
+            ["git", *args],
+            cwd=cwd,
+            check=True,
+            capture_output=True,
+            text=True,
+            timeout=GIT_TIMEOUT_SECONDS,
+        )
+    except FileNotFoundError:
+        raise TemplateSourceError("git is required for --sample-from-git.")
+    except subprocess.TimeoutExpired:
+        raise TemplateSourceError(f"git {args[0]} timed out.")
+    except subprocess.CalledProcessError as e:
+        detail = (e.stderr or e.stdout or "").strip().splitlines()
+        raise TemplateSourceError(
+            f"git {args[0]} failed: {detail[-1] if detail else e.returncode}"
+        )
+
+
+def load_manifest(repo_dir: Path) -> Dict[str, Dict[str, Any]]:
+    """
+    Load and validate the template manifest of a checkout.
+
+    Returns:
+        Template configurations keyed by template name, with ``source_path``
+        resolved to the sample file or directory.
+    """
+    manifest_path = repo_dir / MANIFEST_FILE
+    if not manifest_path.is_file():
+        raise TemplateSourceError(f"Template repository has no {MANIFEST_FILE}.")
+    try:
+        data = yaml.safe_load(manifest_path.read_text(encoding="utf-8")) or {}
+    except yaml.YAMLError as e:
+        raise TemplateSourceError(f"Invalid {MANIFEST_FILE}: {e}")
+
+    entries = data.get("templates") if isinstance(data, dict) else None
+    if not isinstance(entries, dict) or not entries:
+        raise TemplateSourceError(f"{MANIFEST_FILE} declares no templates.")
+
+    root = repo_dir.resolve()
+    templates: Dict[str, Dict[str, Any]] = {}
+    for key, entry in entries.items():
+        if not re.fullmatch(r"[A-Za-z0-9_-]+", str(key)) or not isinstance(entry, dict):
+            raise TemplateSourceError(f"Invalid template '{key}' in {MANIFEST_FILE}.")
+        language = entry.get("language")
+        if language not in SUPPORTED_LANGUAGES:
+            raise TemplateSourceError(
+                f"Template '{key}' has unsupported language '{language}'. "
+                f"Must be one of: {', '.join(SUPPORTED_LANGUAGES)}."
+            )
+        rel = entry.get("file") or entry.get("filepath")
+        if not rel:
+            raise TemplateSourceError(f"Template '{key}' must set file or filepath.")
+        source_path = (root / str(rel)).resolve()
+        if root not in source_path.parents and source_path != root:
+            raise TemplateSourceError(
+                f"Template '{key}' points outside the repository: {rel}"
+            )
+        if not source_path.exists():
+            raise TemplateSourceError(f"Template '{key}' not found: {rel}")
+        _check_symlinks(str(key), source_path)
+
+        conditions = _load_conditions(str(key), entry, source_path)
+
+        templates[str(key)] = {
+            "name": entry.get("name", str(key)),
+            "language": language,
+            "language_version": str(
+                entry.get("language_version", SUPPORTED_LANGUAGES[language])
+            ),
+            "description": entry.get("description", ""),
+            "type": entry.get("type", "Basic App"),
+            "extra_requirements": list(entry.get("extra_requirements", [])),
+            "source_path": source_path,
+            "conditions": conditions,
+        }
+    return templates
+
+
+def _check_symlinks(key: str, source_path: Path) -> None:
+    """Reject symlinks in a template directory that lead out of it."""
+    if not source_path.is_dir():
+        return
+    for directory, dirnames, filenames in os.walk(source_path):
+        for name in dirnames + filenames:
+            path = Path(directory) / name
+            if not path.is_symlink():
+                continue
+            target = path.resolve()
+            if source_path not in target.parents:
+                rel = path.relative_to(source_path).as_posix()
+                raise TemplateSourceError(
+                    f"Template '{key}': symlink points outside the template: {rel}"
+                )
+
+
+def _load_conditions(
+    key: str, entry: Dict[str, Any], source_path: Path
+) -> Dict[str, str]:
+    """Validate the render conditions of a template, keyed by relative path."""
+    raw = entry.get("conditions")
+    if raw is None:
+        return {}
+    if not isinstance(raw, dict):
+        raise TemplateSourceError(
+            f"Template '{key}': conditions must map file paths to expressions."
+        )
+    if raw and not source_path.is_dir():
+        raise TemplateSourceError(
+            f"Template '{key}': conditions require a filepath template."
+        )
+    conditions: Dict[str, str] = {}
+    for rel, condition in raw.items():
+        path = (source_path / str(rel)).resolve()
+        if source_path not in path.parents:
+            raise TemplateSourceError(
+                f"Template '{key}': condition points outside the template: {rel}"
+            )
+        if not path.exists():
+            raise TemplateSourceError(
+                f"Template '{key}': condition for a missing file: {rel}"
+            )
+        if not isinstance(condition, (str, bool)) or condition == "":
+            raise TemplateSourceError(
+                f"Template '{key}': condition for {rel} must be an expression."
+            )
+        conditions[path.relative_to(source_path).as_posix()] = str(condition)
+    return conditions
@@ -0,0 +1,922 @@
+# Copyright (c) 2026 Beijing Volcano Engine Technology Co., Ltd. and/or its affiliates.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+"""Go feature registry - Optional code generation features for Go agent templates.
+
+Each feature maps a set of ScaffoldOptions fields to the Go files rendered from
+``resources/templates/golang/features``. Features are only available for
+templates that declare ``go_features`` in the init template registry.
+"""
+
+import re
+from dataclasses import dataclass
+from pathlib import Path
+from typing import Any, Callable, Dict, List, Optional, Tuple
+
+
+FEATURES_TEMPLATE_DIR = (
+    Path(__file__).parent.parent / "resources" / "templates" / "golang" / "features"
+)
+
+# Standalone tool package generated by agentkit scaffold-tool.
+TOOL_PACKAGE_TEMPLATE_DIR = FEATURES_TEMPLATE_DIR.parent / "tool_package"
+
+# Sample whose go.mod/go.sum pin the Go dependencies for offline scaffolding.
+BUNDLED_GO_PINS_DIR = (
+    Path(__file__).parent.parent / "resources" / "samples" / "veadk_go_basic"
+)
+
+# Always rendered when at least one feature is enabled; wires features into the agent.
+FEATURES_ENTRY_FILE = "features.go"
+
+# go.sum lines of the modules features add to go.mod, so no download is needed.
+FEATURES_GO_SUM = FEATURES_TEMPLATE_DIR / "features.sum"
+
+# Modules the Redis prompt cache adds to go.mod: (path, version, indirect).
+REDIS_GO_REQUIRES = (
+    ("github.com/redis/go-redis/v9", "v9.7.3", False),
+    ("github.com/cespare/xxhash/v2", "v2.2.0", True),
+    ("github.com/dgryski/go-rendezvous", "v0.0.0-20200823014737-9f7001d12a5f", True),
+)
+
+EMPTY_INPUT_MODES = ("error", "default-response", "passthrough")
+DEFAULT_EMPTY_INPUT_RESPONSE = "Please enter a message so I can help you."
+DEFAULT_FALLBACK_STATUS = 200
+PREPROCESS_STEPS = ("trim", "lowercase", "strip-markdown", "expand-abbreviations")
+SCHEMA_ENDPOINT_MODES = ("auto", "on", "off")
+COMPRESS_ALGORITHMS = ("gzip",)
+MAX_STOP_SEQUENCES = 4
+MAX_OUTPUT_RETRIES = 5
+TOOL_REGISTRY_POLICIES = ("fail", "skip")
+SIGNATURE_ALGORITHMS = ("hmac-sha256",)
+PARALLEL_TOOLS_MODES = ("on", "off")
+TOOL_RESULT_FORMATS = ("json", "text")
+# Smallest --tool-result-max-bytes that still leaves room for an error message.
+MIN_TOOL_RESULT_BYTES = 64
+PROMPT_CACHE_BACKENDS = ("inmemory", "redis")
+TRANSPORT_MODES = ("http", "grpc", "both")
+MODERATION_ACTIONS = ("block", "log", "annotate")
+MODERATION_FAIL_MODES = ("closed", "open")
+# Media types --with-attachments accepts unless --attachment-types is given.
+DEFAULT_ATTACHMENT_TYPES = (
+    "text/plain",
+    "text/markdown",
+    "text/csv",
+    "application/json",
+    "application/pdf",
+    "image/png",
+    "image/jpeg",
+)
+# BCP 47 language tag, e.g. en, zh-CN or zh-Hant-TW.
+LANGUAGE_TAG_PATTERN = r"[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*"
+# Go import path of a module or package, e.g. github.com/org/tools/geocode.
+GO_IMPORT_PATH_PATTERN = r"[A-Za-z0-9.-]+(/[A-Za-z0-9._~-]+)*"
+# Identifiers imported by tool_packages.go that a tool package must not shadow.
+RESERVED_TOOL_PACKAGE_NAMES = ("log", "tool", "main")
+# WriteTimeout of the generated apps in main.go.
+HTTP_WRITE_TIMEOUT_SECONDS = 120
+# Ports taken by the generated app and its gateway.
+RESERVED_PORTS = (8000, 18000)
+# Headers added by --secure-headers; --response-headers entries override them.
+SECURE_RESPONSE_HEADERS = (
+    ("X-Content-Type-Options", "nosniff"),
+    ("Cache-Control", "no-store"),
+    ("X-Frame-Options", "DENY"),
+    ("Referrer-Policy", "no-referrer"),
+)
+_DURATION_UNITS = {"ms": 0.001, "s": 1, "m": 60, "h": 3600}
+PENALTY_RANGE = (-2.0, 2.0)
+# Feature options restricted to a fixed set of values, for agentkit schema-export.
+OPTION_CHOICES = {
+    "on_empty_input": EMPTY_INPUT_MODES,
+    "preprocess": PREPROCESS_STEPS,
+    "schema_endpoint": SCHEMA_ENDPOINT_MODES,
+    "compress": COMPRESS_ALGORITHMS,
+    "tool_registry_policy": TOOL_REGISTRY_POLICIES,
+    "verify_signature": SIGNATURE_ALGORITHMS,
+    "parallel_tools": PARALLEL_TOOLS_MODES,
+    "tool_result_format": TOOL_RESULT_FORMATS,
+    "prompt_cache": PROMPT_CACHE_BACKENDS,
+    "transport": TRANSPORT_MODES,
+    "moderation_action": MODERATION_ACTIONS,
+    "moderation_fail_mode": MODERATION_FAIL_MODES,
+}
+
+
+@dataclass(frozen=True)
+class GoFeature:
+    """A code generation feature for Go agent templates."""
+
+    name: str
+    """Feature identifier"""
+
+    summary: str
+    """One-line description of what the generated code does"""
+
+    files: Tuple[str, ...]
+    """Generated Go files (rendered from ``<file>.j2`` in the features directory)"""
+
+    options: Tuple[str, ...]
+    """ScaffoldOptions fields that configure this feature"""
+
+    enabled: Callable[[Any], bool]
+    """Predicate deciding whether the feature is on for the given options"""
+
+    templates: Optional[Tuple[str, ...]] = None
+    """Templates the feature supports; None means every Go feature template"""
+
+    go_requires: Callable[[Any], Tuple[Tuple[str, str, bool], ...]] = lambda o: ()
+    """Modules the generated code imports beyond the sample's go.mod"""
+
+
+GO_FEATURES: List[GoFeature] = [
+    GoFeature(
+        name="generation_params",
+        summary="Sets stop sequences and frequency/presence penalties in the model config.",
+        files=(),
+        options=("stop", "frequency_penalty", "presence_penalty"),
+        enabled=lambda o: bool(o.stop)
+        or o.frequency_penalty is not None
+        or o.presence_penalty is not None,
+    ),
+    GoFeature(
+        name="empty_input",
+        summary="Guards the model call when a request carries no user text.",
+        files=("empty_input.go",),
+        options=("on_empty_input", "empty_input_response"),
+        enabled=lambda o: o.on_empty_input != "passthrough",
+    ),
+    GoFeature(
+        name="preprocess",
+        summary="Runs an ordered pipeline of named steps over the user text before each model call.",
+        files=("preprocess.go", "preprocess.json"),
+        options=("preprocess",),
+        enabled=lambda o: bool(o.preprocess),
+    ),
+    GoFeature(
+        name="max_steps",
+        summary="Caps the model/tool iterations of one request, overridable with AGENT_MAX_STEPS.",
+        files=("max_steps.go",),
+        options=("max_steps",),
+        enabled=lambda o: o.max_steps is not None,
+    ),
+    GoFeature(
+        name="moderation",
+        summary="Sends user input and model output to a moderation service before and after each model call.",
+        files=("moderation.go",),
+        options=("moderation_url", "moderation_action", "moderation_fail_mode"),
+        enabled=lambda o: bool(o.moderation_url),
+    ),
+    GoFeature(
+        name="budget",
+        summary="Estimates the model cost of each request and falls back to a cheaper model at a ceiling.",
+        files=("budget.go", "model_pricing.json"),
+        options=("budget_ceiling", "budget_fallback_model", "budget_pricing"),
+        enabled=lambda o: o.budget_ceiling is not None,
+        # The ceiling is tracked per request through the session_id header.
+        templates=("basic_go",),
+    ),
+    GoFeature(
+        name="circuit_breaker",
+        summary="Fails model calls fast after repeated failures and probes the provider before resuming.",
+        files=("circuit_breaker.go",),
+        options=(
+            "circuit_breaker",
+            "circuit_breaker_threshold",
+            "circuit_breaker_open_duration",
+            "circuit_breaker_probes",
+        ),
+        enabled=lambda o: o.circuit_breaker,
+    ),
+    GoFeature(
+        name="output_schema",
+        summary="Validates replies against a JSON Schema, repairing or retrying malformed JSON.",
+        files=("output_schema.go", "output_schema.json"),
+        options=("output_schema", "output_repair", "output_retries"),
+        enabled=lambda o: bool(o.output_schema),
+    ),
+    GoFeature(
+        name="fallback",
+        summary="Answers with a canned response when the model call fails.",
+        files=("fallback.go",),
+        options=("fallback_response", "fallback_status"),
+        enabled=lambda o: bool(o.fallback_response),
+    ),
+    GoFeature(
+        name="clock",
+        summary="Reads the time through an injectable Clock and tells the model the current date.",
+        files=("clock.go", "clock_test.go"),
+        options=("inject_clock",),
+        enabled=lambda o: o.inject_clock,
+    ),
+    GoFeature(
+        name="compress",
+        summary="Compresses responses with gzip for clients that accept it.",
+        files=("compress.go",),
+        options=("compress", "compress_min_size"),
+        enabled=lambda o: bool(o.compress),
+    ),
+    GoFeature(
+        name="replay",
+        summary="Records session transcripts and serves export and replay endpoints.",
+        files=("replay.go",),
+        options=("with_replay",),
+        enabled=lambda o: o.with_replay,
+        # Replay drives the simple app's /invoke protocol.
+        templates=("basic_go",),
+    ),
+    GoFeature(
+        name="attachments",
+        summary="Accepts multipart /invoke requests with uploaded files, within size and type limits.",
+        files=("attachments.go",),
+        options=("with_attachments", "attachment_max_bytes", "attachment_types"),
+        enabled=lambda o: o.with_attachments,
+        # Uploads are turned into the simple app's /invoke request.
+        templates=("basic_go",),
+    ),
+    GoFeature(
+        name="idempotency",
+        summary="Replays the recorded response to a repeated Idempotency-Key instead of running the agent again.",
+        files=("idempotency.go",),
+        options=("idempotency", "idempotency_ttl"),
+        enabled=lambda o: o.idempotency,
+    ),
+    GoFeature(
+        name="readonly_fs",
+        summary="Keeps all writes in /tmp and logs on stdout for read-only root filesystems.",
+        files=("readonly_fs.go",),
+        options=("readonly_fs",),
+        enabled=lambda o: o.readonly_fs,
+    ),
+    GoFeature(
+        name="loadtest",
+        summary="Adds a k6 load-test script that reports latency percentiles.",
+        files=("loadtest.js",),
+        options=("with_loadtest", "loadtest_vus", "loadtest_duration"),
+        enabled=lambda o: o.with_loadtest,
+    ),
+    GoFeature(
+        name="thinking_bench",
+        summary="Adds a benchmark comparing latency and accuracy with model thinking enabled and disabled.",
+        files=("thinkingbench/main.go", "thinkingbench/prompts.json"),
+        options=("with_thinking_bench", "thinking_bench_runs"),
+        enabled=lambda o: o.with_thinking_bench,
+    ),
+    GoFeature(
+        name="model_override",
+        summary="Lets a request pick an allowlisted model with the X-Model header.",
+        files=("model_override.go",),
+        options=("allow_model_override",),
+        enabled=lambda o: bool(o.allow_model_override),
+        templates=("basic_go",),
+    ),
+    GoFeature(
+        name="prompt_cache",
+        summary="Serves repeated identical prompts from an in-memory or Redis cache within a TTL.",
+        files=("prompt_cache.go",),
+        options=("prompt_cache", "prompt_cache_ttl"),
+        enabled=lambda o: bool(o.prompt_cache),
+        templates=("basic_go",),
+        go_requires=lambda o: REDIS_GO_REQUIRES if o.prompt_cache == "redis" else (),
+    ),
+    GoFeature(
+        name="session_ttl",
+        summary="Evicts sessions that have been idle for longer than a TTL from the session service.",
+        files=("session_ttl.go",),
+        options=("session_ttl",),
+        enabled=lambda o: bool(o.session_ttl),
+    ),
+    GoFeature(
+        name="parallel_tools",
+        summary="Runs the tool calls of one model response concurrently, bounded by a limit.",
+        files=("parallel_tools.go",),
+        options=("parallel_tools", "parallel_tools_limit"),
+        enabled=lambda o: o.parallel_tools == "on",
+    ),
+    GoFeature(
+        name="tool_progress",
+        summary="Streams start, heartbeat, progress and completion events of running tool calls to SSE clients.",
+        files=("tool_progress.go",),
+        options=("tool_progress", "tool_progress_interval"),
+        enabled=lambda o: o.tool_progress,
+        # Events are written into the simple app's SSE responses.
+        templates=("basic_go",),
+    ),
+    GoFeature(
+        name="tool_result",
+        summary="Normalizes tool results and errors into one JSON or text shape, truncating large results.",
+        files=("tool_result.go",),
+        options=("tool_result_format", "tool_result_max_bytes"),
+        enabled=lambda o: bool(o.tool_result_format),
+    ),
+    GoFeature(
+        name="access_log",
+        summary="Logs every request with status, duration and bodies, masking redaction patterns.",
+        files=("access_log.go",),
+        options=("access_log", "access_log_body_limit", "redact_logs"),
+        enabled=lambda o: o.access_log,
+    ),
+    GoFeature(
+        name="path_prefix",
+        summary="Serves all routes under a path prefix for path-based ingress routing.",
+        files=(),
+        options=("path_prefix",),
+        enabled=lambda o: bool(o.path_prefix),
+    ),
+    GoFeature(
+        name="prompt_version",
+        summary="Stamps the prompt version into response headers, logs and GET /version.",
+        files=("prompt_version.go",),
+        options=("prompt_version",),
+        enabled=lambda o: bool(o.prompt_version),
+    ),
+    GoFeature(
+        name="config_driven",
+        summary="Reads the prompt and model from config.yaml and swaps in edits on POST /reload.",
+        files=("config_driven.go", "config.yaml"),
+        options=("config_driven", "reload_token_env"),
+        enabled=lambda o: o.config_driven,
+    ),
+    GoFeature(
+        name="config_history",
+        summary="Records each loaded config.yaml version with its diff and serves GET /config/history.",
+        files=("config_history.go",),
+        options=("config_history", "config_history_limit"),
+        enabled=lambda o: o.config_history,
+    ),
+    GoFeature(
+        name="tool_package",
+        summary="Adds the tools of reusable Go packages created with agentkit scaffold-tool.",
+        files=("tool_packages.go",),
+        options=("tool_package",),
+        enabled=lambda o: bool(o.tool_package),
+    ),
+    GoFeature(
+        name="tool_registry",
+        summary="Loads tool definitions from a registry at startup and calls them over HTTP.",
+        files=("tool_registry.go",),
+        options=("tool_registry_url", "tool_registry_policy", "tool_registry_retries"),
+        enabled=lambda o: bool(o.tool_registry_url),
+    ),
+    GoFeature(
+        name="model_timeout",
+        summary="Gives each model call its own deadline, separate from the HTTP timeouts.",
+        files=("model_timeout.go",),
+        options=("model_call_timeout",),
+        enabled=lambda o: bool(o.model_call_timeout),
+        # A timed-out call sets the status of its turn, found through the
+        # session_id header.
+        templates=("basic_go",),
+    ),
+    GoFeature(
+        name="request_context",
+        summary="Injects configured request headers into a per-turn system message.",
+        files=("request_context.go", "request_context.json"),
+        options=("context_headers",),
+        enabled=lambda o: bool(o.context_headers),
+        templates=("basic_go",),
+    ),
+    GoFeature(
+        name="tenant",
+        summary="Rejects requests without a tenant header and scopes sessions, memory, transcripts and cache entries to the tenant.",
+        files=("tenant.go",),
+        options=("tenant_header",),
+        enabled=lambda o: bool(o.tenant_header),
+        # Tenants are told apart through the simple app's session and user headers.
+        templates=("basic_go",),
+    ),
+    GoFeature(
+        name="localize",
+        summary="Tells the model to answer in the language negotiated from the Accept-Language header.",
+        files=("localize.go",),
+        options=("localize", "localize_default", "localize_languages"),
+        enabled=lambda o: o.localize,
+        templates=("basic_go",),
+    ),
+    GoFeature(
+        name="signature",
+        summary="Rejects requests whose HMAC signature header does not match the body.",
+        files=("signature.go",),
+        options=("verify_signature", "signature_header", "signature_secret_env"),
+        enabled=lambda o: bool(o.verify_signature),
+    ),
+    GoFeature(
+        name="warmup",
+        summary="Sends a warm-up request at startup and reports readiness on GET /readyz.",
+        files=("warmup.go",),
+        options=("warmup", "warmup_timeout"),
+        enabled=lambda o: o.warmup,
+    ),
+    GoFeature(
+        name="pprof",
+        summary="Serves net/http/pprof profiling endpoints on a separate address.",
+        files=("pprof.go",),
+        options=("pprof", "pprof_addr", "pprof_token_env"),
+        enabled=lambda o: o.pprof,
+    ),
+    GoFeature(
+        name="dump_config",
+        summary="Adds a --dump-config flag that prints the resolved configuration and exits.",
+        files=("dump_config.go",),
+        options=("dump_config",),
+        enabled=lambda o: o.dump_config,
+    ),
+    GoFeature(
+        name="probe_deps",
+        summary="Adds a --probe-deps flag that checks the model endpoint and configured stores are reachable and exits.",
+        files=("probe_deps.go",),
+        options=("probe_deps", "probe_timeout"),
+        enabled=lambda o: o.probe_deps,
+    ),
+    GoFeature(
+        name="grpc",
+        summary="Serves a gRPC ChatService defined in chat.proto next to or instead of HTTP.",
+        files=("grpc.go", "chat.proto", "chat.pb.go", "chat_grpc.pb.go"),
+        options=("transport", "grpc_port"),
+        enabled=lambda o: o.transport != "http",
+        templates=("basic_go",),
+    ),
+    GoFeature(
+        name="response_headers",
+        summary="Sets default headers, such as security headers, on every response.",
+        files=("response_headers.go",),
+        options=("response_headers", "secure_headers"),
+        enabled=lambda o: bool(response_header_values(o)),
+    ),
+    GoFeature(
+        name="schema",
+        summary="Serves the agent's tool declarations as JSON on GET /schema.",
+        files=("schema.go",),
+        options=("schema_endpoint",),
+        # In auto mode the endpoint is always generated, since tools can come
+        # from the sample or be added to agent.go later, and only mounted if
+        # the agent has tools once they have loaded.
+        enabled=lambda o: _schema_enabled(o),
+    ),
+    GoFeature(
+        name="gateway",
+        summary="Serves the public port and forwards to the VeADK app, hosting HTTP-level features.",
+        files=("gateway.go",),
+        options=(),
+        enabled=lambda o: _needs_gateway(o) or _schema_enabled(o),
+    ),
+]
+
+
+def parse_duration(value: str) -> Optional[float]:
+    """Parse a Go-style duration such as 45s or 1m30s into seconds."""
+    parts = re.findall(r"(\d+(?:\.\d+)?)(ms|s|m|h)", value or "")
+    if not parts or "".join(n + u for n, u in parts) != value:
+        return None
+    return sum(float(n) * _DURATION_UNITS[u] for n, u in parts)
+
+
+def go_duration(value: str) -> str:
+    """Render a duration string as a Go time.Duration expression."""
+    millis = round(parse_duration(value) * 1000)
+    if millis % 1000 == 0:
+        return f"{millis // 1000} * time.Second"
+    return f"{millis} * time.Millisecond"
+
+
+def parse_response_header(value: str) -> Optional[Tuple[str, str]]:
+    """Parse a 'Name: value' header option; return None if it is malformed."""
+    name, sep, header_value = value.partition(":")
+    name, header_value = name.strip(), header_value.strip()
+    if not sep or not re.fullmatch(r"[A-Za-z0-9!#$%&'*+.^_`|~-]+", name):
+        return None
+    if not header_value or re.search(r"[\r\n]", header_value):
+        return None
+    return name, header_value
+
+
+def response_header_values(options: Any) -> List[Tuple[str, str]]:
+    """Return the headers the gateway sets on every response, in order."""
+    headers: Dict[str, Tuple[str, str]] = {}
+    if options.secure_headers:
+        for name, value in SECURE_RESPONSE_HEADERS:
+            headers[name.lower()] = (name, value)
+    for raw in options.response_headers or []:
+        parsed = parse_response_header(raw)
+        if parsed:
+            headers[parsed[0].lower()] = parsed
+    return list(headers.values())
+
+
+def context_header_values(options: Any) -> List[Tuple[str, str]]:
+    """Return (header, label) pairs of --context-headers entries."""
+    pairs: List[Tuple[str, str]] = []
+    for raw in options.context_headers or []:
+        header, _, label = raw.partition("=")
+        pairs.append((header.strip(), label.strip() or header.strip()))
+    return pairs
+
+
+def localize_language_values(options: Any) -> List[str]:
+    """Return the supported --localize languages, the default first."""
+    languages: Dict[str, str] = {}
+    for lang in [options.localize_default, *(options.localize_languages or [])]:
+        languages.setdefault(lang.strip().lower(), lang.strip())
+    return list(languages.values())
+
+
+def attachment_type_values(options: Any) -> List[str]:
+    """Return the media types --with-attachments accepts, lowercased."""
+    types = options.attachment_types or DEFAULT_ATTACHMENT_TYPES
+    return list(dict.fromkeys(t.strip().lower() for t in types))
+
+
+def _needs_gateway(options: Any) -> bool:
+    """Whether an enabled feature has to work at the HTTP level."""
+    return (
+        bool(options.compress)
+        or bool(options.path_prefix)
+        or bool(options.prompt_version)
+        or options.config_driven
+        or bool(options.model_call_timeout)
+        or bool(options.verify_signature)
+        or options.warmup
+        or options.access_log
+        or bool(options.prompt_cache)
+        or bool(options.allow_model_override)
+        or bool(options.context_headers)
+        or options.localize
+        or bool(options.tenant_header)
+        or options.budget_ceiling is not None
+        or bool(options.output_schema)
+        or options.tool_progress
+        or bool(response_header_values(options))
+        or options.with_replay
+        or options.with_attachments
+        or options.idempotency
+        or options.transport != "http"
+        or (
+            bool(options.fallback_response)
+            and options.fallback_status != DEFAULT_FALLBACK_STATUS
+        )
+    )
+
+
+def _schema_enabled(options: Any) -> bool:
+    """Whether the /schema endpoint is generated."""
+    return options.schema_endpoint in ("auto", "on")
+
+
+def enabled_features(options: Any) -> List[GoFeature]:
+    """Return the features enabled by the given scaffold options."""
+    return [feature for feature in GO_FEATURES if feature.enabled(options)]
+
+
+def go_requirements(options: Any) -> List[Tuple[str, str, bool]]:
+    """Return the modules the enabled features add to go.mod."""
+    requires = []
+    for feature in enabled_features(options):
+        for require in feature.go_requires(options):
+            if require not in requires:
+                requires.append(require)
+    return requires
+
+
+def feature_option_names(template: Optional[str] = None) -> List[str]:
+    """
+    Return the ScaffoldOptions fields owned by Go features.
+
+    Args:
+        template: Only return the options of features supporting this template.
+    """
+    names: List[str] = []
+    for feature in GO_FEATURES:
+        if template and feature.templates is not None:
+            if template not in feature.templates:
+                continue
+        names.extend(feature.options)
+    return names
+
+
+def unsupported_feature_error(options: Any, template: str) -> Optional[str]:
+    """Return an error if an enabled feature does not support the template."""
+    for feature in enabled_features(options):
+        if feature.templates is not None and template not in feature.templates:
+            flags = ", ".join(f"--{name.replace('_', '-')}" for name in feature.options)
+            return (
+                f"Template '{template}' does not support {flags} "
+                f"(supported: {', '.join(feature.templates)})"
+            )
+    return None
+
+
+def validate_options(options: Any) -> Optional[str]:
+    """
+    Validate Go feature options.
+
+    Returns:
+        An error message for the first invalid option, or None if all are valid.
+    """
+    if options.on_empty_input not in EMPTY_INPUT_MODES:
+        return (
+            f"Invalid --on-empty-input '{options.on_empty_input}'. "
+            f"Must be one of: {', '.join(EMPTY_INPUT_MODES)}."
+        )
+    if options.on_empty_input == "default-response" and not (
+        options.empty_input_response or ""
+    ).strip():
+        return "--empty-input-response must not be empty when --on-empty-input is default-response."
+    for step in options.preprocess or []:
+        if step not in PREPROCESS_STEPS:
+            return (
+                f"Invalid --preprocess '{step}'. "
+                f"Must be one of: {', '.join(PREPROCESS_STEPS)}."
+            )
+    if options.schema_endpoint not in SCHEMA_ENDPOINT_MODES:
+        return (
+            f"Invalid --schema-endpoint '{options.schema_endpoint}'. "
+            f"Must be one of: {', '.join(SCHEMA_ENDPOINT_MODES)}."
+        )
+    if options.compress and options.compress not in COMPRESS_ALGORITHMS:
+        return (
+            f"Invalid --compress '{options.compress}'. "
+            f"Must be one of: {', '.join(COMPRESS_ALGORITHMS)}."
+        )
+    if options.compress_min_size < 0:
+        return "--compress-min-size must not be negative."
+    if options.attachment_max_bytes < 1:
+        return "--attachment-max-bytes must be at least 1."
+    for media_type in options.attachment_types or []:
+        if not re.fullmatch(r"[\w.+-]+/([\w.+-]+|\*)", media_type.strip()):
+            return (
+                f"Invalid --attachment-types '{media_type}'. "
+                "Use a media type such as application/pdf or image/*."
+            )
+    if not parse_duration(options.idempotency_ttl):
+        return (
+            f"Invalid --idempotency-ttl '{options.idempotency_ttl}'. "
+            "Use a duration such as 1h or 24h."
+        )
+    if options.loadtest_vus < 1:
+        return "--loadtest-vus must be at least 1."
+    if not re.fullmatch(r"(\d+(ms|s|m|h))+", options.loadtest_duration):
+        return (
+            f"Invalid --loadtest-duration '{options.loadtest_duration}'. "
+            "Use a k6 duration such as 30s, 5m or 1h30m."
+        )
+    if options.thinking_bench_runs < 1:
+        return "--thinking-bench-runs must be at least 1."
+    if options.path_prefix is not None and not re.fullmatch(
+        r"(/[A-Za-z0-9._~-]+)+", options.path_prefix
+    ):
+        return (
+            f"Invalid --path-prefix '{options.path_prefix}'. "
+            "It must start with '/', have no trailing '/' and contain only URL-safe segments, e.g. /agents/myagent."
+        )
+    if options.prompt_version is not None and not re.fullmatch(
+        r"[A-Za-z0-9._+:-]{1,64}", options.prompt_version
+    ):
+        return (
+            f"Invalid --prompt-version '{options.prompt_version}'. "
+            "Use up to 64 letters, digits and . _ + : - characters."
+        )
+    if options.config_driven:
+        if not re.fullmatch(r"[A-Za-z_][A-Za-z0-9_]*", options.reload_token_env):
+            return f"Invalid --reload-token-env '{options.reload_token_env}'."
+        if options.inject_clock:
+            return "--config-driven cannot be combined with --inject-clock."
+        if options.transport == "grpc":
+            return "--config-driven serves POST /reload and needs --transport http or both."
+    if options.config_history:
+        if not options.config_driven:
+            return "--config-history requires --config-driven."
+        if options.config_history_limit < 1:
+            return "--config-history-limit must be at least 1."
+    if options.stop is not None:
+        if len(options.stop) > MAX_STOP_SEQUENCES:
+            return f"At most {MAX_STOP_SEQUENCES} --stop sequences are allowed."
+        if not all(options.stop):
+            return "--stop sequences must not be empty."
+    for flag, value in (
+        ("--frequency-penalty", options.frequency_penalty),
+        ("--presence-penalty", options.presence_penalty),
+    ):
+        low, high = PENALTY_RANGE
+        if value is not None and not low <= value <= high:
+            return f"Invalid {flag} {value}. Must be between {low} and {high}."
+    if options.tool_registry_url is not None and not re.match(
+        r"https?://[^\s/]+", options.tool_registry_url
+    ):
+        return f"Invalid --tool-registry-url '{options.tool_registry_url}'. Must be an http(s) URL."
+    package_names = set()
+    for path in options.tool_package or []:
+        name = path.rsplit("/", 1)[-1]
+        if (
+            not re.fullmatch(GO_IMPORT_PATH_PATTERN, path)
+            or "/" not in path
+            or not re.fullmatch(r"[a-z][a-z0-9]*", name)
+        ):
+            return (
+                f"Invalid --tool-package '{path}'. "
+                "Use the import path of a package created with agentkit scaffold-tool, "
+                "e.g. github.com/org/tools/geocode."
+            )
+        if name in RESERVED_TOOL_PACKAGE_NAMES or name in package_names:
+            return f"--tool-package '{path}' clashes with another package named '{name}'."
+        package_names.add(name)
+    if options.moderation_url is not None and not re.match(
+        r"https?://[^\s/]+", options.moderation_url
+    ):
+        return f"Invalid --moderation-url '{options.moderation_url}'. Must be an http(s) URL."
+    if options.moderation_action not in MODERATION_ACTIONS:
+        return (
+            f"Invalid --moderation-action '{options.moderation_action}'. "
+            f"Must be one of: {', '.join(MODERATION_ACTIONS)}."
+        )
+    if options.moderation_fail_mode not in MODERATION_FAIL_MODES:
+        return (
+            f"Invalid --moderation-fail-mode '{options.moderation_fail_mode}'. "
+            f"Must be one of: {', '.join(MODERATION_FAIL_MODES)}."
+        )
+    if options.tool_registry_policy not in TOOL_REGISTRY_POLICIES:
+        return (
+            f"Invalid --tool-registry-policy '{options.tool_registry_policy}'. "
+            f"Must be one of: {', '.join(TOOL_REGISTRY_POLICIES)}."
+        )
+    for name in options.allow_model_override or []:
+        if not re.fullmatch(r"[A-Za-z0-9._:/-]+", name):
+            return (
+                f"Invalid --allow-model-override '{name}'. "
+                "Use a model name such as doubao-seed-1-6-250615."
+            )
+    if options.prompt_cache is not None:
+        if options.prompt_cache not in PROMPT_CACHE_BACKENDS:
+            return (
+                f"Invalid --prompt-cache '{options.prompt_cache}'. "
+                f"Must be one of: {', '.join(PROMPT_CACHE_BACKENDS)}."
+            )
+        if not parse_duration(options.prompt_cache_ttl):
+            return (
+                f"Invalid --prompt-cache-ttl '{options.prompt_cache_ttl}'. "
+                "Use a duration such as 10m or 1h."
+            )
+    if options.session_ttl is not None and not parse_duration(options.session_ttl):
+        return (
+            f"Invalid --session-ttl '{options.session_ttl}'. "
+            "Use a duration such as 30m or 2h."
+        )
+    if options.parallel_tools not in PARALLEL_TOOLS_MODES:
+        return (
+            f"Invalid --parallel-tools '{options.parallel_tools}'. "
+            f"Must be one of: {', '.join(PARALLEL_TOOLS_MODES)}."
+        )
+    if options.parallel_tools_limit < 1:
+        return "--parallel-tools-limit must be at least 1."
+    if (
+        options.tool_result_format is not None
+        and options.tool_result_format not in TOOL_RESULT_FORMATS
+    ):
+        return (
+            f"Invalid --tool-result-format '{options.tool_result_format}'. "
+            f"Must be one of: {', '.join(TOOL_RESULT_FORMATS)}."
+        )
+    if options.tool_result_max_bytes < MIN_TOOL_RESULT_BYTES:
+        return f"--tool-result-max-bytes must be at least {MIN_TOOL_RESULT_BYTES}."
+    if options.tool_registry_retries < 0:
+        return "--tool-registry-retries must not be negative."
+    if options.model_call_timeout is not None:
+        seconds = parse_duration(options.model_call_timeout)
+        if not seconds:
+            return (
+                f"Invalid --model-call-timeout '{options.model_call_timeout}'. "
+                "Use a duration such as 45s or 1m30s."
+            )
+        if seconds >= HTTP_WRITE_TIMEOUT_SECONDS:
+            return (
+                f"--model-call-timeout must be shorter than the HTTP write timeout "
+                f"({HTTP_WRITE_TIMEOUT_SECONDS}s)."
+            )
+    if options.verify_signature:
+        if options.verify_signature not in SIGNATURE_ALGORITHMS:
+            return (
+                f"Invalid --verify-signature '{options.verify_signature}'. "
+                f"Must be one of: {', '.join(SIGNATURE_ALGORITHMS)}."
+            )
+        if not re.fullmatch(r"[A-Za-z0-9-]+", options.signature_header):
+            return f"Invalid --signature-header '{options.signature_header}'."
+        if not re.fullmatch(r"[A-Za-z_][A-Za-z0-9_]*", options.signature_secret_env):
+            return f"Invalid --signature-secret-env '{options.signature_secret_env}'."
+    if not parse_duration(options.warmup_timeout):
+        return (
+            f"Invalid --warmup-timeout '{options.warmup_timeout}'. "
+            "Use a duration such as 30s or 1m."
+        )
+    if not parse_duration(options.probe_timeout):
+        return (
+            f"Invalid --probe-timeout '{options.probe_timeout}'. "
+            "Use a duration such as 5s or 500ms."
+        )
+    if options.pprof:
+        match = re.fullmatch(
+            r"([A-Za-z0-9.-]*|\[[0-9A-Fa-f:]+\]):(\d{1,5})", options.pprof_addr
+        )
+        if not match or not 0 < int(match.group(2)) < 65536:
+            return (
+                f"Invalid --pprof-addr '{options.pprof_addr}'. "
+                "Use host:port such as 127.0.0.1:6060."
+            )
+        if int(match.group(2)) in RESERVED_PORTS:
+            ports = ", ".join(map(str, RESERVED_PORTS))
+            return f"--pprof-addr must not use the agent ports ({ports})."
+        if options.pprof_token_env is not None and not re.fullmatch(
+            r"[A-Za-z_][A-Za-z0-9_]*", options.pprof_token_env
+        ):
+            return f"Invalid --pprof-token-env '{options.pprof_token_env}'."
+    if options.output_schema:
+        if not 0 <= options.output_retries <= MAX_OUTPUT_RETRIES:
+            return f"--output-retries must be between 0 and {MAX_OUTPUT_RETRIES}."
+    elif not options.output_repair or options.output_retries != 1:
+        return "--no-output-repair and --output-retries require --output-schema."
+    if options.transport not in TRANSPORT_MODES:
+        return (
+            f"Invalid --transport '{options.transport}'. "
+            f"Must be one of: {', '.join(TRANSPORT_MODES)}."
+        )
+    if not 0 < options.grpc_port < 65536:
+        return f"Invalid --grpc-port {options.grpc_port}. Must be between 1 and 65535."
+    if options.grpc_port in RESERVED_PORTS:
+        ports = ", ".join(map(str, RESERVED_PORTS))
+        return f"--grpc-port must not use the agent ports ({ports})."
+    for header, label in context_header_values(options):
+        if not re.fullmatch(r"[A-Za-z0-9-]+", header) or re.search(r"[\r\n]", label):
+            return (
+                f"Invalid --context-headers '{header}={label}'. "
+                "Use Header=label, e.g. X-Tenant-Id=tenant."
+            )
+    if options.tenant_header is not None and not re.fullmatch(
+        r"[A-Za-z0-9-]+", options.tenant_header
+    ):
+        return (
+            f"Invalid --tenant-header '{options.tenant_header}'. "
+            "Use a header name such as X-Tenant-ID."
+        )
+    if not re.fullmatch(LANGUAGE_TAG_PATTERN, options.localize_default):
+        return (
+            f"Invalid --localize-default '{options.localize_default}'. "
+            "Use a BCP 47 language tag such as en or zh-CN."
+        )
+    for lang in options.localize_languages or []:
+        if not re.fullmatch(LANGUAGE_TAG_PATTERN, lang):
+            return (
+                f"Invalid --localize-languages '{lang}'. "
+                "Use a BCP 47 language tag such as en or zh-CN."
+            )
+    for raw in options.response_headers or []:
+        if parse_response_header(raw) is None:
+            return (
+                f"Invalid --response-headers '{raw}'. "
+                "Use Name:value, e.g. 'Cache-Control: no-store'."
+            )
+    if options.access_log_body_limit < 0:
+        return "--access-log-body-limit must not be negative."
+    if options.redact_logs and not options.access_log:
+        return "--redact-logs requires --access-log."
+    if options.max_steps is not None and options.max_steps < 1:
+        return "--max-steps must be at least 1."
+    if not parse_duration(options.tool_progress_interval):
+        return (
+            f"Invalid --tool-progress-interval '{options.tool_progress_interval}'. "
+            "Use a duration such as 5s or 500ms."
+        )
+    if options.budget_ceiling is not None:
+        if options.budget_ceiling <= 0:
+            return "--budget-ceiling must be greater than 0."
+        if not options.budget_fallback_model:
+            return "--budget-ceiling requires --budget-fallback-model."
+    elif options.budget_fallback_model or options.budget_pricing:
+        return "--budget-fallback-model and --budget-pricing require --budget-ceiling."
+    if options.circuit_breaker_threshold < 1:
+        return "--circuit-breaker-threshold must be at least 1."
+    if not parse_duration(options.circuit_breaker_open_duration):
+        return (
+            f"Invalid --circuit-breaker-open-duration '{options.circuit_breaker_open_duration}'. "
+            "Use a duration such as 30s or 1m."
+        )
+    if options.circuit_breaker_probes < 1:
+        return "--circuit-breaker-probes must be at least 1."
+    if options.fallback_response is not None and not options.fallback_response.strip():
+        return "--fallback-response must not be empty."
+    if not 200 <= options.fallback_status <= 599:
+        return f"Invalid --fallback-status {options.fallback_status}. Must be between 200 and 599."
+    if (
+        options.fallback_status != DEFAULT_FALLBACK_STATUS
+        and not options.fallback_response
+    ):
+        return "--fallback-status requires --fallback-response."
+    return None
@@ -0,0 +1,139 @@
+# Copyright (c) 2026 Beijing Volcano Engine Technology Co., Ltd. and/or its affiliates.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+"""Init explain - Describe the files and features of a generated project.
+
+The descriptions come from the Go feature registry and the options the project
+was generated with, so they match what ``agentkit init`` actually produced.
+"""
+
+import shlex
+from dataclasses import MISSING, fields
+from typing import Any, Dict, List, Optional, Tuple
+
+from . import go_features
+
+
+# Roles of the files every template of a language can generate.
+FILE_ROLES = {
+    "agent.go": "Builds the agent: name, instruction, model and tools. Edit it to change what the agent does.",
+    "build.sh": "Builds the agent binary; the container build runs it.",
+    "go.mod": "Go module definition and direct dependencies.",
+    "go.sum": "Checksums of the pinned Go dependencies; update with go mod tidy.",
+    "go.work": "Go workspace the project was added to.",
+    go_features.FEATURES_ENTRY_FILE: "Wires the enabled features into the agent config, in the order their callbacks run.",
+    "agentkit.yaml": "AgentKit project configuration: entry point, runtime environment variables and deployment settings. Edit it or run agentkit config.",
+    "requirements.txt": "Python dependencies installed into the image.",
+    ".dockerignore": "Files kept out of the container build context.",
+    ".gitignore": "Files kept out of the git repository.",
+}
+
+
+def explain_project(
+    created_files: List[str],
+    language: str,
+    template_name: str,
+    entry_point: str,
+    scaffold_options: Any,
+    file_roles: Optional[Dict[str, str]] = None,
+) -> Dict[str, List[Dict[str, str]]]:
+    """
+    Describe a generated project for --explain.
+
+    Args:
+        created_files: Files created by agentkit init, relative to the project.
+        language: Project language (Python or Golang).
+        template_name: Display name of the template.
+        entry_point: Entry point file of the project.
+        scaffold_options: The ScaffoldOptions the project was generated with.
+        file_roles: Roles of files specific to this project, e.g. a copied
+            agent file; they take precedence over the generic roles.
+
+    Returns:
+        ``files``: one ``{"path", "role"}`` entry per created file.
+        ``features``: one ``{"name", "summary", "files", "options"}`` entry per
+        enabled Go feature; ``options`` lists the flags that set it.
+    """
+    features = []
+    if language.lower() == "golang":
+        features = go_features.enabled_features(scaffold_options)
+    feature_of_file = {f: feature for feature in features for f in feature.files}
+
+    files = []
+    for path in created_files:
+        if file_roles and path in file_roles:
+            role = file_roles[path]
+        elif path in feature_of_file:
+            feature = feature_of_file[path]
+            role = f"{feature.summary} Generated for the {feature.name} feature."
+        elif path == "main.go":
+            role = _main_role(features)
+        elif path in FILE_ROLES:
+            role = FILE_ROLES[path]
+        elif path == entry_point:
+            role = (
+                f"Entry point of the agent, from the {template_name} template. "
+                "Edit it to change the agent."
+            )
+        else:
+            role = f"Part of the {template_name} template."
+        files.append({"path": path, "role": role})
+
+    return {
+        "files": files,
+        "features": [
+            {
+                "name": feature.name,
+                "summary": feature.summary,
+                "files": ", ".join(feature.files),
+                "options": _option_flags(scaffold_options, feature.options),
+            }
+            for feature in features
+        ],
+    }
+
+
+def _main_role(features: List[go_features.GoFeature]) -> str:
+    names = {feature.name for feature in features}
+    servers = [
+        server
+        for feature, server in (
+            ("gateway", "the gateway in front of it"),
+            ("grpc", "the gRPC server"),
+            ("pprof", "the pprof server"),
+        )
+        if feature in names
+    ]
+    started = ", ".join(["the VeADK app", *servers[:-1]])
+    if servers:
+        started += f" and {servers[-1]}"
+    return f"Starts {started}. Ports and server timeouts are set here."
+
+
+def _option_flags(options: Any, names: Tuple[str, ...]) -> str:
+    """Return the flags that set the given options, defaults left out."""
+    defaults = {f.name: f.default for f in fields(options) if f.default is not MISSING}
+    flags = []
+    for name in names:
+        value = getattr(options, name)
+        if value == defaults.get(name) or value is None:
+            continue
+        flag = f"--{name.replace('_', '-')}"
+        if isinstance(value, bool):
+            flags.append(flag if value else f"--no-{name.replace('_', '-')}")
+        elif isinstance(value, list):
+            flags.extend(f"{flag} {shlex.quote(str(v))}" for v in value)
+        else:
+            flags.append(f"{flag} {shlex.quote(str(value))}")
+    return " ".join(flags) or "defaults"
@@ -0,0 +1,71 @@
+# Copyright (c) 2026 Beijing Volcano Engine Technology Co., Ltd. and/or its affiliates.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+"""Log redaction - Regex rules masking sensitive data in generated access logs."""
+
+import re
+from pathlib import Path
+from typing import List
+
+
+# Constructs Python accepts but Go's RE2-based regexp package rejects.
+_UNSUPPORTED_SYNTAX = [
+    (r"\(\?<?[=!]", "lookaround"),
+    (r"\\[1-9]", "backreference"),
+    (r"\(\?P=", "named backreference"),
+    (r"[*+?}][+]", "possessive quantifier"),
+    (r"\(\?>", "atomic group"),
+]
+
+
+def load_redact_patterns(path: str) -> List[str]:
+    """
+    Read redaction patterns from a file, one regular expression per line.
+
+    Blank lines and lines starting with ``#`` are ignored. Patterns must use
+    the RE2 syntax understood by Go's regexp package.
+
+    Args:
+        path: Path of the pattern file.
+
+    Returns:
+        The patterns in file order.
+
+    Raises:
+        FileNotFoundError: If the pattern file does not exist.
+        ValueError: If a pattern is invalid or uses syntax Go does not support.
+    """
+    if not Path(path).is_file():
+        raise FileNotFoundError(f"Redaction pattern file not found: {path}")
+
+    patterns: List[str] = []
+    lines = Path(path).read_text(encoding="utf-8").splitlines()
+    for lineno, line in enumerate(lines, 1):
+        pattern = line.strip()
+        if not pattern or pattern.startswith("#"):
+            continue
+        for syntax, label in _UNSUPPORTED_SYNTAX:
+            if re.search(syntax, pattern):
+                raise ValueError(
+                    f"{path}:{lineno}: {label} is not supported by Go regular expressions."
+                )
+        try:
+            re.compile(pattern)
+        except re.error as e:
+            raise ValueError(f"{path}:{lineno}: invalid pattern: {e}")
+        patterns.append(pattern)
+
+    if not patterns:
+        raise ValueError(f"Redaction pattern file has no patterns: {path}")
+    return patterns
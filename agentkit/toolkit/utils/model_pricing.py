@@ -0,0 +1,81 @@
+# Copyright (c) 2026 Beijing Volcano Engine Technology Co., Ltd. and/or its affiliates.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+"""Model pricing - Per-model token prices used by the --budget-ceiling feature.
+
+A pricing table is a JSON object::
+
+    {
+      "currency": "CNY",
+      "per_tokens": 1000000,
+      "models": {"doubao-seed-1-6-250615": {"input": 0.8, "output": 8.0}}
+    }
+
+Prices are per ``per_tokens`` input and output tokens. The bundled table holds
+list prices of common Ark models and may be out of date; pass your own with
+--budget-pricing.
+"""
+
+import json
+from pathlib import Path
+from typing import Any, Dict, Optional
+
+BUNDLED_PRICING_FILE = Path(__file__).parent.parent / "resources" / "model_pricing.json"
+
+
+def load_model_pricing(path: Optional[str] = None) -> Dict[str, Any]:
+    """
+    Load and validate a pricing table.
+
+    Args:
+        path: Pricing file; the bundled table is used when None.
+
+    Returns:
+        The table with currency and per_tokens filled in.
+
+    Raises:
+        FileNotFoundError: If the pricing file does not exist.
+        ValueError: If the file is not a valid pricing table.
+    """
+    source = Path(path) if path else BUNDLED_PRICING_FILE
+    if not source.is_file():
+        raise FileNotFoundError(f"Pricing file not found: {source}")
+    try:
+        data = json.loads(source.read_text(encoding="utf-8"))
+    except json.JSONDecodeError as e:
+        raise ValueError(f"Invalid pricing file {source}: {e}")
+
+    models = data.get("models") if isinstance(data, dict) else None
+    if not isinstance(models, dict) or not models:
+        raise ValueError(f"Pricing file {source} has no models.")
+    for name, price in models.items():
+        if not isinstance(price, dict) or not all(
+            isinstance(price.get(k), (int, float)) and price[k] >= 0
+            for k in ("input", "output")
+        ):
+            raise ValueError(
+                f"Pricing file {source}: model '{name}' needs non-negative "
+                "input and output prices."
+            )
+    per_tokens = data.get("per_tokens", 1000000)
+    if not isinstance(per_tokens, int) or per_tokens < 1:
+        raise ValueError(f"Pricing file {source}: per_tokens must be a positive integer.")
+    return {
+        "currency": str(data.get("currency", "")),
+        "per_tokens": per_tokens,
+        "models": {
+            str(name): {"input": price["input"], "output": price["output"]}
+            for name, price in models.items()
+        },
+    }
@@ -0,0 +1,54 @@
+# Copyright (c) 2026 Beijing Volcano Engine Technology Co., Ltd. and/or its affiliates.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+"""Output schema - JSON Schema that replies of generated Go agents must match."""
+
+import json
+from pathlib import Path
+from typing import Any, Dict
+
+_JSON_TYPES = {"object", "array", "string", "number", "integer", "boolean", "null"}
+
+
+def load_output_schema(path: str) -> Dict[str, Any]:
+    """
+    Load a JSON Schema file for --output-schema.
+
+    Only the shape is checked here; the generated agent resolves the schema
+    with github.com/google/jsonschema-go at startup.
+
+    Args:
+        path: Path of the schema file.
+
+    Returns:
+        The schema object.
+
+    Raises:
+        FileNotFoundError: If the schema file does not exist.
+        ValueError: If the file is not a JSON Schema object.
+    """
+    source = Path(path)
+    if not source.is_file():
+        raise FileNotFoundError(f"Output schema file not found: {path}")
+    try:
+        schema = json.loads(source.read_text(encoding="utf-8"))
+    except json.JSONDecodeError as e:
+        raise ValueError(f"Invalid output schema {path}: {e}")
+    if not isinstance(schema, dict) or not schema:
+        raise ValueError(f"Output schema {path} must be a non-empty JSON object.")
+    types = schema.get("type", [])
+    for name in types if isinstance(types, list) else [types]:
+        if name not in _JSON_TYPES:
+            raise ValueError(f"Output schema {path} has an invalid type: {name!r}")
+    return schema
@@ -0,0 +1,50 @@
+# Copyright (c) 2026 Beijing Volcano Engine Technology Co., Ltd. and/or its affiliates.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+
+"""Prompt fragments - Compose a system prompt from shared fragment files."""
+
+from pathlib import Path
+from typing import List
+
+
+DEFAULT_FRAGMENT_SEPARATOR = "\n\n"
+
+
+def compose_prompt(
+    fragments: List[str], separator: str = DEFAULT_FRAGMENT_SEPARATOR
+) -> str:
+    """
+    Concatenate prompt fragment files into a single system prompt.
+
+    Fragments are joined in the given order; surrounding whitespace of each
+    fragment is stripped so the separator alone controls the spacing.
+
+    Args:
+        fragments: Paths of the fragment files.
+        separator: Text inserted between consecutive fragments.
+
+    Returns:
+        The composed prompt.
+
+    Raises:
+        FileNotFoundError: If a fragment file does not exist.
+    """
+    missing = [f for f in fragments if not Path(f).is_file()]
+    if missing:
+        raise FileNotFoundError(
+            f"Prompt fragment not found: {', '.join(missing)}"
+        )
+    parts = [Path(f).read_text(encoding="utf-8").strip() for f in fragments]
+    return separator.join(parts)
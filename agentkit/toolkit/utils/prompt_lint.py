@@ -0,0 +1,104 @@
+# Copyright (c) 2026 Beijing Volcano Engine Technology Co., Ltd. and/or its affiliates.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+"""Prompt lint - Heuristic checks for agent system prompts at scaffold time."""
+
+import re
+from typing import List
+
+
+MIN_PROMPT_LENGTH = 20
+MAX_PROMPT_LENGTH = 8000
+
+_ROLE_PATTERNS = [
+    r"\byou are\b",
+    r"\byou're\b",
+    r"\bact as\b",
+    r"\byour (role|job|task) is\b",
+    r"\bas an? [a-z]",
+    r"你是",
+    r"你将扮演",
+    r"作为一名",
+    r"作为一个",
+]
+
+_PLACEHOLDER_PATTERNS = [
+    (r"{{.*?}}", "template expression"),
+    (r"{%.*?%}", "template tag"),
+    (r"\$\{[A-Za-z_][A-Za-z0-9_]*\}", "variable reference"),
+    (r"<[A-Z][A-Z0-9_ ]{2,}>", "angle-bracket placeholder"),
+    (r"\[(INSERT|TODO|TBD|PLACEHOLDER)[^\]]*\]", "bracket placeholder"),
+    (r"\b(TODO|FIXME|TBD)\b", "to-do marker"),
+]
+
+_PII_PATTERNS = [
+    (r"[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}", "email address"),
+    (r"(?<!\d)1[3-9]\d{9}(?!\d)", "mobile phone number"),
+    (r"(?<!\d)\+?\d{1,3}[ -]\(?\d{3}\)?[ -]\d{3}[ -]\d{4}(?!\d)", "phone number"),
+    (r"(?<!\d)\d{17}[\dXx](?!\d)", "ID card number"),
+    (r"(?<!\d)(?:\d{4}[ -]?){3}\d{4}(?!\d)", "card number"),
+]
+
+
+def lint_prompt(prompt: str) -> List[str]:
+    """
+    Run heuristic checks on a system prompt.
+
+    Checks length bounds, the presence of a role statement, unresolved template
+    placeholders and leftover personal data.
+
+    Args:
+        prompt: The system prompt / instruction text.
+
+    Returns:
+        List of human-readable warnings; empty when no problem was found.
+    """
+    warnings: List[str] = []
+    text = prompt.strip()
+
+    if len(text) < MIN_PROMPT_LENGTH:
+        warnings.append(
+            f"Prompt is very short ({len(text)} chars, minimum {MIN_PROMPT_LENGTH}); "
+            "the agent may lack enough guidance."
+        )
+    elif len(text) > MAX_PROMPT_LENGTH:
+        warnings.append(
+            f"Prompt is very long ({len(text)} chars, maximum {MAX_PROMPT_LENGTH}); "
+            "consider trimming it to reduce cost and contradictions."
+        )
+
+    if text and not any(re.search(p, text, re.IGNORECASE) for p in _ROLE_PATTERNS):
+        warnings.append(
+            "Prompt has no role statement (e.g. 'You are a ...'); "
+            "role framing makes agent behavior more stable."
+        )
+
+    # Patterns overlap (a '[TODO ...]' is also a to-do marker), so each span
+    # is reported once, by the first pattern that matches it.
+    reported: List[range] = []
+    for pattern, label in _PLACEHOLDER_PATTERNS:
+        for match in re.finditer(pattern, text, re.S):
+            if any(match.start() in span for span in reported):
+                continue
+            reported.append(range(match.start(), match.end()))
+            warnings.append(f"Unresolved {label} found: '{match.group(0)[:40]}'.")
+            break
+
+    for pattern, label in _PII_PATTERNS:
+        if re.search(pattern, text):
+            warnings.append(
+                f"Prompt appears to contain a {label}; remove personal data before shipping."
+            )
+
+    return warnings
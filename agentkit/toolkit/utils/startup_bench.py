@@ -0,0 +1,183 @@
+# Copyright (c) 2026 Beijing Volcano Engine Technology Co., Ltd. and/or its affiliates.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+"""Startup bench - Time how long a generated Go agent takes to get ready."""
+
+import shutil
+import socket
+import statistics
+import subprocess
+import tempfile
+import time
+import urllib.error
+import urllib.request
+from dataclasses import dataclass, field
+from pathlib import Path
+from typing import List, Sequence, Tuple
+
+# Served by agents generated with --warmup; 503 until the warm-up call is done.
+READY_PATH = "/readyz"
+# Public port of the generated agents (the gateway, or the app without one).
+DEFAULT_PORT = 8000
+BUILD_TIMEOUT_SECONDS = 600
+POLL_INTERVAL_SECONDS = 0.05
+STOP_TIMEOUT_SECONDS = 10
+
+
+class StartupBenchError(Exception):
+    """Raised when the agent cannot be built or does not get ready."""
+
+
+@dataclass
+class StartupBenchResult:
+    """Timings of one startup bench."""
+
+    build_seconds: float
+    """Time taken by go build"""
+
+    startup_seconds: List[float] = field(default_factory=list)
+    """Time from process start to ready, per run"""
+
+    readiness_probe: bool = True
+    """Whether readiness came from /readyz; otherwise from the first HTTP response"""
+
+    @property
+    def median(self) -> float:
+        return statistics.median(self.startup_seconds)
+
+
+def build_agent(module_dir: Path, output: Path) -> float:
+    """
+    Build the Go agent in module_dir into the binary output.
+
+    Returns:
+        The build time in seconds.
+    """
+    if shutil.which("go") is None:
+        raise StartupBenchError("go is required to build the agent.")
+    start = time.monotonic()
+    try:
+        result = subprocess.run(
+            ["go", "build", "-o", str(output), "."],
+            cwd=module_dir,
+            capture_output=True,
+            text=True,
+            timeout=BUILD_TIMEOUT_SECONDS,
+        )
+    except subprocess.TimeoutExpired:
+        raise StartupBenchError(f"go build timed out after {BUILD_TIMEOUT_SECONDS}s.")
+    if result.returncode != 0:
+        raise StartupBenchError(f"go build failed: {_tail(result.stderr)}")
+    return time.monotonic() - start
+
+
+def time_to_ready(
+    command: Sequence[str], cwd: Path, port: int, timeout: float
+) -> Tuple[float, bool]:
+    """
+    Start the agent and wait until it is ready, then stop it.
+
+    The agent is ready when GET /readyz answers 200. Agents generated without
+    --warmup have no readiness probe; for them a 404 means the server is
+    listening, which is the best available signal.
+
+    Returns:
+        Seconds from process start to ready, and whether /readyz was used.
+    """
+    if _port_in_use(port):
+        raise StartupBenchError(f"Port {port} is already in use.")
+    url = f"http://127.0.0.1:{port}{READY_PATH}"
+    with tempfile.TemporaryFile() as log:
+        start = time.monotonic()
+        proc = subprocess.Popen(
+            list(command), cwd=cwd, stdout=log, stderr=subprocess.STDOUT
+        )
+        try:
+            while True:
+                status = _probe(url)
+                elapsed = time.monotonic() - start
+                if status in (200, 404):
+                    return elapsed, status == 200
+                if proc.poll() is not None:
+                    log.seek(0)
+                    output = log.read().decode("utf-8", errors="replace")
+                    raise StartupBenchError(
+                        f"Agent exited with code {proc.returncode} before it was "
+                        f"ready: {_tail(output)}"
+                    )
+                if elapsed > timeout:
+                    raise StartupBenchError(
+                        f"Agent was not ready after {timeout:g}s "
+                        f"(last {READY_PATH} status: {status or 'no response'})."
+                    )
+                time.sleep(POLL_INTERVAL_SECONDS)
+        finally:
+            _stop(proc)
+
+
+def bench_startup(
+    module_dir: Path, runs: int = 3, port: int = DEFAULT_PORT, timeout: float = 60.0
+) -> StartupBenchResult:
+    """
+    Build the agent once, then boot it runs times and time each cold start.
+
+    Args:
+        module_dir: Directory of the agent's main package and go.mod.
+        runs: Number of boots to time.
+        port: Port the agent serves on.
+        timeout: Seconds to wait for each boot to get ready.
+    """
+    if not (module_dir / "go.mod").is_file():
+        raise StartupBenchError(
+            f"{module_dir} is not a Go module; bench-startup supports Go agents."
+        )
+    with tempfile.TemporaryDirectory(prefix="agentkit-bench-") as tmp:
+        binary = Path(tmp) / "agent"
+        result = StartupBenchResult(build_seconds=build_agent(module_dir, binary))
+        for _ in range(runs):
+            seconds, probe = time_to_ready([str(binary)], module_dir, port, timeout)
+            result.startup_seconds.append(seconds)
+            result.readiness_probe = result.readiness_probe and probe
+    return result
+
+
+def _probe(url: str) -> int:
+    try:
+        with urllib.request.urlopen(url, timeout=1) as resp:
+            return resp.status
+    except urllib.error.HTTPError as e:
+        return e.code
+    except (urllib.error.URLError, ConnectionError, TimeoutError):
+        return 0
+
+
+def _port_in_use(port: int) -> bool:
+    with socket.socket(socket.AF_INET, socket.SOCK_STREAM) as sock:
+        return sock.connect_ex(("127.0.0.1", port)) == 0
+
+
+def _stop(proc: subprocess.Popen) -> None:
+    if proc.poll() is not None:
+        return
+    proc.terminate()
+    try:
+        proc.wait(timeout=STOP_TIMEOUT_SECONDS)
+    except subprocess.TimeoutExpired:
+        proc.kill()
+        proc.wait()
+
+
+def _tail(output: str) -> str:
+    lines = (output or "").strip().splitlines()
+    return lines[-1] if lines else "no output"
@@ -0,0 +1,132 @@
+# Copyright (c) 2026 Beijing Volcano Engine Technology Co., Ltd. and/or its affiliates.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+"""Vars schema - JSON Schema of the variables an init sample is rendered with."""
+
+import ast
+import inspect
+import textwrap
+import typing
+from dataclasses import MISSING, fields
+from typing import Any, Dict, Iterable, Mapping, Sequence
+
+JSON_SCHEMA_DIALECT = "https://json-schema.org/draft/2020-12/schema"
+
+# Variables every sample is rendered with, keyed by name. project_name is the
+# positional argument of agentkit init; the others have a flag of their own.
+SAMPLE_VARIABLES: Dict[str, Dict[str, Any]] = {
+    "project_name": {
+        "type": "string",
+        "description": "Project name; names the generated entry file or binary",
+        "pattern": "^[a-zA-Z0-9_-]+$",
+    },
+    "agent_name": {
+        "type": "string",
+        "description": "Agent name",
+        "x-agentkit-flag": "--agent-name",
+    },
+    "description": {
+        "type": "string",
+        "description": "Agent description",
+        "x-agentkit-flag": "--description",
+    },
+    "system_prompt": {
+        "type": "string",
+        "description": "System prompt of the agent",
+        "x-agentkit-flag": "--system-prompt",
+    },
+    "model_name": {
+        "type": "string",
+        "description": "Model name in volcengine ARK platform",
+        "x-agentkit-flag": "--model-name",
+    },
+    "tools": {
+        "type": "string",
+        "description": "Comma-separated list of tools",
+        "x-agentkit-flag": "--tools",
+    },
+}
+REQUIRED_VARIABLES = ("project_name",)
+
+_JSON_TYPES = {bool: "boolean", int: "integer", float: "number", str: "string"}
+
+
+def field_docs(cls: type) -> Dict[str, str]:
+    """Return the docstrings written below the fields of a dataclass."""
+    tree = ast.parse(textwrap.dedent(inspect.getsource(cls)))
+    body = tree.body[0].body
+    docs: Dict[str, str] = {}
+    for node, doc in zip(body, body[1:]):
+        if (
+            isinstance(node, ast.AnnAssign)
+            and isinstance(node.target, ast.Name)
+            and isinstance(doc, ast.Expr)
+            and isinstance(doc.value, ast.Constant)
+            and isinstance(doc.value.value, str)
+        ):
+            docs[node.target.id] = " ".join(doc.value.value.split())
+    return docs
+
+
+def json_type(annotation: Any) -> Dict[str, Any]:
+    """Map a field annotation to a JSON Schema type; Optional is unwrapped."""
+    args = [arg for arg in typing.get_args(annotation) if arg is not type(None)]
+    origin = typing.get_origin(annotation)
+    if origin is typing.Union and len(args) == 1:
+        return json_type(args[0])
+    if origin is list:
+        return {"type": "array", "items": json_type(args[0])}
+    if annotation not in _JSON_TYPES:
+        raise TypeError(f"No JSON Schema type for {annotation!r}")
+    return {"type": _JSON_TYPES[annotation]}
+
+
+def dataclass_properties(
+    cls: type,
+    names: Iterable[str],
+    choices: Mapping[str, Sequence[Any]],
+) -> Dict[str, Dict[str, Any]]:
+    """
+    Describe dataclass fields as JSON Schema properties.
+
+    Args:
+        cls: Dataclass whose fields are described, e.g. ScaffoldOptions.
+        names: Fields to describe; properties follow the dataclass field order.
+        choices: Allowed values per field; for list fields they apply to items.
+
+    Returns:
+        Properties keyed by field name, each with its type, description, flag
+        and, unless it is None, its default.
+    """
+    wanted = set(names)
+    hints = typing.get_type_hints(cls)
+    docs = field_docs(cls)
+    properties: Dict[str, Dict[str, Any]] = {}
+    for f in fields(cls):
+        if f.name not in wanted:
+            continue
+        prop = json_type(hints[f.name])
+        if f.name in choices:
+            target = prop["items"] if prop["type"] == "array" else prop
+            target["enum"] = list(choices[f.name])
+        if f.name in docs:
+            prop["description"] = docs[f.name]
+        default = f.default
+        if default is MISSING and f.default_factory is not MISSING:
+            default = f.default_factory()
+        if default is not MISSING and default is not None:
+            prop["default"] = default
+        prop["x-agentkit-flag"] = f"--{f.name.replace('_', '-')}"
+        properties[f.name] = prop
+    return properties
@@ -0,0 +1,32 @@
+# Copyright (c) 2026 Beijing Volcano Engine Technology Co., Ltd. and/or its affiliates.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+import pytest
+
+
+@pytest.fixture
+def executor(monkeypatch):
+    """InitExecutor that sees no global config."""
+    import agentkit.toolkit.executors.init_executor as init_mod
+    import agentkit.toolkit.config.global_config as global_cfg_mod
+    from agentkit.toolkit.executors.init_executor import InitExecutor
+
+    def _raise() -> None:
+        raise RuntimeError("no global config")
+
+    monkeypatch.setattr(init_mod, "global_config_exists", lambda: False)
+    monkeypatch.setattr(init_mod, "get_global_config", _raise)
+    monkeypatch.setattr(global_cfg_mod, "global_config_exists", lambda: False)
+    monkeypatch.setattr(global_cfg_mod, "get_global_config", _raise)
+    return InitExecutor()
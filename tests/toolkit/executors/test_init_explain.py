@@ -0,0 +1,106 @@
+# Copyright (c) 2026 Beijing Volcano Engine Technology Co., Ltd. and/or its affiliates.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+from __future__ import annotations
+
+from pathlib import Path
+
+
+def test_explain_describes_go_files_and_features(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(with_replay=True, session_ttl="30m"),
+        explain=True,
+    )
+
+    assert result.success, result.error
+    explanation = result.metadata["explanation"]
+    roles = {entry["path"]: entry["role"] for entry in explanation["files"]}
+    assert set(roles) == set(result.created_files)
+    assert roles["main.go"].startswith(
+        "Starts the VeADK app and the gateway in front of it."
+    )
+    assert roles["replay.go"].endswith("Generated for the replay feature.")
+    features = {entry["name"]: entry for entry in explanation["features"]}
+    assert set(features) == {"replay", "session_ttl", "schema", "gateway"}
+    assert features["session_ttl"]["options"] == "--session-ttl 30m"
+    assert features["replay"]["options"] == "--with-replay"
+    assert features["gateway"]["options"] == "defaults"
+
+
+def test_explain_python_template_has_no_features(tmp_path: Path, executor) -> None:
+    result = executor.init_project(
+        project_name="demo",
+        template="basic",
+        directory=str(tmp_path),
+        explain=True,
+    )
+
+    assert result.success, result.error
+    explanation = result.metadata["explanation"]
+    assert explanation["features"] == []
+    roles = {entry["path"]: entry["role"] for entry in explanation["files"]}
+    assert roles["demo.py"].startswith("Entry point of the agent")
+
+
+def test_explain_is_off_by_default(tmp_path: Path, executor) -> None:
+    result = executor.init_project(
+        project_name="demo", template="basic_go", directory=str(tmp_path)
+    )
+
+    assert result.success, result.error
+    assert "explanation" not in result.metadata
+
+
+def test_from_agent_no_network_records_offline_note(
+    tmp_path: Path, executor
+) -> None:
+    agent_file = tmp_path / "my_agent.py"
+    agent_file.write_text('from veadk import Agent\n\nagent = Agent(name="a")\n')
+
+    result = executor.init_from_agent_file(
+        project_name="demo",
+        agent_file_path=str(agent_file),
+        directory=str(tmp_path / "out"),
+        no_network=True,
+    )
+
+    assert result.success, result.error
+    assert result.metadata["offline_notes"] == [
+        "Wrapping an agent file needs no network access."
+    ]
+
+
+def test_explain_from_agent_describes_wrapper_files(tmp_path: Path, executor) -> None:
+    agent_file = tmp_path / "my_agent.py"
+    agent_file.write_text('from veadk import Agent\n\nagent = Agent(name="a")\n')
+
+    result = executor.init_from_agent_file(
+        project_name="demo",
+        agent_file_path=str(agent_file),
+        directory=str(tmp_path / "out"),
+        explain=True,
+    )
+
+    assert result.success, result.error
+    explanation = result.metadata["explanation"]
+    assert explanation["features"] == []
+    roles = {entry["path"]: entry["role"] for entry in explanation["files"]}
+    assert set(roles) == set(result.created_files)
+    assert roles["my_agent.py"].startswith("Your agent definition, copied unchanged")
+    assert roles["demo.py"].startswith("Entry point of the agent")
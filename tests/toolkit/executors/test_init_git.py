@@ -0,0 +1,85 @@
+# Copyright (c) 2026 Beijing Volcano Engine Technology Co., Ltd. and/or its affiliates.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+from __future__ import annotations
+
+import subprocess
+from pathlib import Path
+
+import pytest
+
+
+@pytest.fixture(autouse=True)
+def git_identity(monkeypatch, tmp_path):
+    monkeypatch.setenv("GIT_CEILING_DIRECTORIES", str(tmp_path))
+    for role in ("AUTHOR", "COMMITTER"):
+        monkeypatch.setenv(f"GIT_{role}_NAME", "t")
+        monkeypatch.setenv(f"GIT_{role}_EMAIL", "t@example.com")
+
+
+def _git(repo: Path, *args: str) -> str:
+    return subprocess.run(
+        ["git", *args], cwd=repo, check=True, capture_output=True, text=True
+    ).stdout
+
+
+def test_init_git_commits_generated_files(tmp_path: Path, executor) -> None:
+    out = tmp_path / "demo"
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(out),
+        model_api_key="sk-test",
+        init_git=True,
+    )
+
+    assert result.success, result.error
+    assert result.metadata["init_git"].endswith("with an initial commit.")
+    assert ".gitignore" in result.created_files
+    committed = _git(out, "ls-files").split()
+    assert {".gitignore", "main.go", "go.mod"} <= set(committed)
+    assert "agentkit.yaml" not in committed
+    assert _git(out, "status", "--porcelain") == ""
+
+
+def test_init_git_skips_existing_repository(tmp_path: Path, executor) -> None:
+    out = tmp_path / "demo"
+    out.mkdir()
+    _git(out, "init", "--quiet")
+
+    result = executor.init_project(
+        project_name="demo", template="basic_go", directory=str(out), init_git=True
+    )
+
+    assert result.success, result.error
+    assert "already in a git repository" in result.metadata["init_git"]
+    assert not (out / ".gitignore").exists()
+    assert _git(out, "rev-list", "--all") == ""
+
+
+def test_init_git_leaves_unrelated_files_out(tmp_path: Path, executor) -> None:
+    out = tmp_path / "demo"
+    out.mkdir()
+    (out / "notes.txt").write_text("scratch\n", encoding="utf-8")
+
+    result = executor.init_project(
+        project_name="demo", template="basic_go", directory=str(out), init_git=True
+    )
+
+    assert result.success, result.error
+    committed = _git(out, "ls-files").split()
+    assert "main.go" in committed
+    assert "notes.txt" not in committed
+    assert _git(out, "status", "--porcelain") == "?? notes.txt\n"
@@ -0,0 +1,301 @@
+# Copyright (c) 2026 Beijing Volcano Engine Technology Co., Ltd. and/or its affiliates.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+from __future__ import annotations
+
+import subprocess
+from pathlib import Path
+
+import pytest
+
+
+MANIFEST = """\
+templates:
+  support_bot:
+    name: Support Bot
+    language: Python
+    type: Basic App
+    description: Customer support agent
+    file: support_bot.py
+"""
+
+
+@pytest.fixture(autouse=True)
+def template_cache(monkeypatch, tmp_path):
+    import agentkit.toolkit.utils.git_templates as git_templates
+
+    monkeypatch.setattr(git_templates, "TEMPLATE_CACHE_DIR", tmp_path / "cache")
+
+
+def _git(repo: Path, *args: str) -> None:
+    subprocess.run(
+        ["git", "-c", "user.name=t", "-c", "user.email=t@example.com", *args],
+        cwd=repo,
+        check=True,
+        capture_output=True,
+    )
+
+
+def _template_repo(root: Path, body: str, manifest: str = MANIFEST) -> Path:
+    repo = root / "templates"
+    repo.mkdir()
+    (repo / "agentkit-templates.yaml").write_text(manifest, encoding="utf-8")
+    (repo / "support_bot.py").write_text(body, encoding="utf-8")
+    _git(repo, "init", "--quiet", "--initial-branch", "main")
+    _git(repo, "add", "-A")
+    _git(repo, "commit", "--quiet", "-m", "templates")
+    return repo
+
+
+def test_sample_from_git_renders_manifest_template(tmp_path: Path, executor) -> None:
+    repo = _template_repo(tmp_path, 'AGENT_NAME = "{{ agent_name }}"\n')
+    _git(repo, "tag", "v1")
+    out = tmp_path / "out"
+
+    result = executor.init_project(
+        project_name="bot",
+        template="support_bot",
+        directory=str(out),
+        agent_name="Helper",
+        sample_from_git=f"file://{repo}#v1",
+    )
+
+    assert result.success, result.error
+    assert 'AGENT_NAME = "Helper"' in (out / "bot.py").read_text(encoding="utf-8")
+
+
+def test_sample_from_git_uses_cache_until_refresh(tmp_path: Path, executor) -> None:
+    repo = _template_repo(tmp_path, "VERSION = 1\n")
+    source = f"file://{repo}#main"
+    assert executor.init_project(
+        project_name="first",
+        template="support_bot",
+        directory=str(tmp_path / "first"),
+        sample_from_git=source,
+    ).success
+
+    (repo / "support_bot.py").write_text("VERSION = 2\n", encoding="utf-8")
+    _git(repo, "commit", "--quiet", "-am", "bump")
+
+    for name, refresh, expected in (
+        ("cached", False, "VERSION = 1"),
+        ("refreshed", True, "VERSION = 2"),
+    ):
+        result = executor.init_project(
+            project_name=name,
+            template="support_bot",
+            directory=str(tmp_path / name),
+            sample_from_git=source,
+            refresh=refresh,
+        )
+        assert result.success, result.error
+        assert expected in (tmp_path / name / f"{name}.py").read_text(encoding="utf-8")
+
+
+def test_sample_from_git_caches_similar_refs_apart(tmp_path: Path, executor) -> None:
+    repo = _template_repo(tmp_path, "VERSION = 1\n")
+    _git(repo, "branch", "a/b")
+    (repo / "support_bot.py").write_text("VERSION = 2\n", encoding="utf-8")
+    _git(repo, "commit", "--quiet", "-am", "bump")
+    _git(repo, "branch", "a_b")
+
+    for ref, expected in (("a/b", "VERSION = 1"), ("a_b", "VERSION = 2")):
+        out = tmp_path / ref.replace("/", "-")
+        result = executor.init_project(
+            project_name="bot",
+            template="support_bot",
+            directory=str(out),
+            sample_from_git=f"file://{repo}#{ref}",
+        )
+        assert result.success, result.error
+        assert expected in (out / "bot.py").read_text(encoding="utf-8")
+
+
+def test_sample_from_git_rejects_paths_outside_repo(tmp_path: Path, executor) -> None:
+    manifest = MANIFEST.replace("file: support_bot.py", "file: ../secret.py")
+    repo = _template_repo(tmp_path, "", manifest=manifest)
+
+    result = executor.init_project(
+        project_name="bot",
+        template="support_bot",
+        directory=str(tmp_path / "out"),
+        sample_from_git=f"file://{repo}",
+    )
+
+    assert not result.success
+    assert result.error_code == "TEMPLATE_FETCH_FAILED"
+    assert "outside the repository" in result.error
+
+
+def test_sample_from_git_no_network_uses_cache_only(tmp_path: Path, executor) -> None:
+    repo = _template_repo(tmp_path, "VERSION = 1\n")
+    source = f"file://{repo}#main"
+
+    uncached = executor.init_project(
+        project_name="bot",
+        template="support_bot",
+        directory=str(tmp_path / "uncached"),
+        sample_from_git=source,
+        no_network=True,
+    )
+    assert not uncached.success
+    assert uncached.error_code == "TEMPLATE_FETCH_FAILED"
+    assert "not cached" in uncached.error
+
+    assert executor.init_project(
+        project_name="bot",
+        template="support_bot",
+        directory=str(tmp_path / "online"),
+        sample_from_git=source,
+    ).success
+    offline = executor.init_project(
+        project_name="bot",
+        template="support_bot",
+        directory=str(tmp_path / "offline"),
+        sample_from_git=source,
+        no_network=True,
+    )
+    assert offline.success, offline.error
+    assert offline.metadata["offline_notes"] == [
+        f"Used the cached checkout of {source}."
+    ]
+
+
+def _directory_template_repo(root: Path, conditions: str) -> Path:
+    repo = root / "templates"
+    sample = repo / "go_bot"
+    (sample / "auth").mkdir(parents=True)
+    (sample / "agent.go").write_text("package main\n", encoding="utf-8")
+    (sample / "tools.go").write_text("package main\n", encoding="utf-8")
+    (sample / "auth" / "auth.go").write_text("package auth\n", encoding="utf-8")
+    (sample / "auth" / "README.md").write_text("auth\n", encoding="utf-8")
+    manifest = (
+        "templates:\n"
+        "  go_bot:\n"
+        "    language: Golang\n"
+        "    filepath: go_bot\n"
+        f"    conditions:\n{conditions}"
+    )
+    (repo / "agentkit-templates.yaml").write_text(manifest, encoding="utf-8")
+    _git(repo, "init", "--quiet", "--initial-branch", "main")
+    _git(repo, "add", "-A")
+    _git(repo, "commit", "--quiet", "-m", "templates")
+    return repo
+
+
+def test_sample_from_git_skips_files_by_condition(tmp_path: Path, executor) -> None:
+    repo = _directory_template_repo(
+        tmp_path,
+        "      tools.go: tools and 'web_search' in tools\n"
+        "      auth/auth.go: agent_name == 'Secure'\n",
+    )
+
+    for name, agent_name, tools in (
+        ("plain", "Helper", None),
+        ("full", "Secure", "web_search"),
+    ):
+        out = tmp_path / name
+        result = executor.init_project(
+            project_name=name,
+            template="go_bot",
+            directory=str(out),
+            agent_name=agent_name,
+            tools=tools,
+            sample_from_git=f"file://{repo}",
+        )
+        assert result.success, result.error
+        selected = name == "full"
+        assert (out / "tools.go").exists() is selected
+        assert (out / "auth" / "auth.go").exists() is selected
+        assert (out / "auth" / "README.md").exists()
+
+
+@pytest.mark.parametrize(
+    "conditions, message",
+    [
+        ("      missing.go: tools\n", "missing file"),
+        ("      ../secret.go: tools\n", "outside the template"),
+        ("      tools.go: ''\n", "must be an expression"),
+    ],
+)
+def test_sample_from_git_rejects_invalid_conditions(
+    tmp_path: Path, executor, conditions: str, message: str
+) -> None:
+    repo = _directory_template_repo(tmp_path, conditions)
+
+    result = executor.init_project(
+        project_name="bot",
+        template="go_bot",
+        directory=str(tmp_path / "out"),
+        sample_from_git=f"file://{repo}",
+    )
+
+    assert not result.success
+    assert result.error_code == "TEMPLATE_FETCH_FAILED"
+    assert message in result.error
+
+
+def test_sample_from_git_reports_condition_syntax_error(
+    tmp_path: Path, executor
+) -> None:
+    repo = _directory_template_repo(tmp_path, "      tools.go: \"tools and\"\n")
+
+    result = executor.init_project(
+        project_name="bot",
+        template="go_bot",
+        directory=str(tmp_path / "out"),
+        sample_from_git=f"file://{repo}",
+    )
+
+    assert not result.success
+    assert result.error_code == "INVALID_CONFIG"
+    assert "invalid condition for tools.go" in result.error
+
+
+def test_sample_from_git_conditions_are_sandboxed(tmp_path: Path, executor) -> None:
+    repo = _directory_template_repo(
+        tmp_path, "      tools.go: \"''.__class__.__mro__[1].__subclasses__()\"\n"
+    )
+
+    result = executor.init_project(
+        project_name="bot",
+        template="go_bot",
+        directory=str(tmp_path / "out"),
+        sample_from_git=f"file://{repo}",
+    )
+
+    assert not result.success
+    assert result.error_code == "INVALID_CONFIG"
+    assert "invalid condition for tools.go" in result.error
+
+
+def test_sample_from_git_rejects_symlinks_out_of_template(
+    tmp_path: Path, executor
+) -> None:
+    repo = _directory_template_repo(tmp_path, "      tools.go: tools\n")
+    (tmp_path / "secret.txt").write_text("secret\n", encoding="utf-8")
+    (repo / "go_bot" / "auth" / "key.txt").symlink_to(tmp_path / "secret.txt")
+    _git(repo, "add", "-A")
+    _git(repo, "commit", "--quiet", "-m", "link")
+
+    result = executor.init_project(
+        project_name="bot",
+        template="go_bot",
+        directory=str(tmp_path / "out"),
+        sample_from_git=f"file://{repo}",
+    )
+
+    assert not result.success
+    assert result.error_code == "TEMPLATE_FETCH_FAILED"
+    assert "symlink points outside the template: auth/key.txt" in result.error
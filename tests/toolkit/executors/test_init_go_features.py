@@ -0,0 +1,1685 @@
+# Copyright (c) 2026 Beijing Volcano Engine Technology Co., Ltd. and/or its affiliates.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+from __future__ import annotations
+
+import shutil
+import subprocess
+from pathlib import Path
+
+import pytest
+
+
+# (template, scaffold options, part of the error) for option combinations
+# init must reject before writing any file.
+INVALID_OPTIONS = [
+    ("basic", {"on_empty_input": "error"}, "--on-empty-input"),
+    ("basic_go", {"on_empty_input": "ignore"}, "--on-empty-input"),
+    ("basic", {"layout": "monorepo"}, "--layout monorepo"),
+    (
+        "basic_go",
+        {"fallback_response": "Sorry.", "fallback_status": 700},
+        "--fallback-status",
+    ),
+    ("basic_go", {"compress": "brotli"}, "--compress"),
+    ("a2a_go", {"with_replay": True}, "--with-replay"),
+    (
+        "basic_go",
+        {"with_attachments": True, "attachment_types": ["pdf"]},
+        "--attachment-types",
+    ),
+    (
+        "basic_go",
+        {"with_attachments": True, "attachment_max_bytes": 0},
+        "--attachment-max-bytes",
+    ),
+    (
+        "basic_go",
+        {"idempotency": True, "idempotency_ttl": "1 day"},
+        "--idempotency-ttl",
+    ),
+    (
+        "basic_go",
+        {"with_loadtest": True, "loadtest_duration": "10"},
+        "--loadtest-duration",
+    ),
+    ("basic_go", {"path_prefix": "/agents/faq/"}, "--path-prefix"),
+    (
+        "basic_go",
+        {"config_driven": True, "reload_token_env": "OPS-TOKEN"},
+        "--reload-token-env",
+    ),
+    ("basic_go", {"config_driven": True, "inject_clock": True}, "--inject-clock"),
+    ("basic_go", {"config_driven": True, "transport": "grpc"}, "--transport"),
+    ("basic_go", {"config_history": True}, "requires --config-driven"),
+    (
+        "basic_go",
+        {"config_driven": True, "config_history": True, "config_history_limit": 0},
+        "--config-history-limit",
+    ),
+    ("basic_go", {"frequency_penalty": 2.5}, "--frequency-penalty"),
+    (
+        "basic_go",
+        {
+            "tool_registry_url": "https://tools.example.com",
+            "tool_registry_policy": "retry",
+        },
+        "--tool-registry-policy",
+    ),
+    ("basic_go", {"model_call_timeout": "2m"}, "--model-call-timeout"),
+    ("basic_go", {"pprof": True, "pprof_addr": ":8000"}, "--pprof-addr"),
+    ("basic_go", {"response_headers": ["X-Frame-Options"]}, "--response-headers"),
+    ("basic_go", {"redact_logs": "redact.txt"}, "--access-log"),
+    ("a2a_go", {"prompt_cache": "inmemory"}, "--prompt-cache"),
+    ("basic_go", {"tool_package": ["geocode"]}, "--tool-package"),
+    ("basic_go", {"tool_package": ["github.com/org/geo-code"]}, "--tool-package"),
+    (
+        "basic_go",
+        {"tool_package": ["a.com/geocode", "b.com/geocode"]},
+        "--tool-package",
+    ),
+    ("basic_go", {"allow_model_override": ["bad model"]}, "--allow-model-override"),
+    ("a2a_go", {"allow_model_override": ["deepseek-v3"]}, "--allow-model-override"),
+    ("basic_go", {"max_steps": 0}, "--max-steps"),
+    ("basic_go", {"transport": "websocket"}, "--transport"),
+    ("basic_go", {"transport": "grpc", "grpc_port": 18000}, "--grpc-port"),
+    ("a2a_go", {"transport": "both"}, "--transport"),
+    ("basic_go", {"session_ttl": "forever"}, "--session-ttl"),
+    ("basic_go", {"probe_deps": True, "probe_timeout": "soon"}, "--probe-timeout"),
+    ("basic_go", {"moderation_url": "moderation.example.com"}, "--moderation-url"),
+    (
+        "basic_go",
+        {"moderation_url": "https://m.example.com", "moderation_action": "drop"},
+        "--moderation-action",
+    ),
+    (
+        "basic_go",
+        {"moderation_url": "https://m.example.com", "moderation_fail_mode": "maybe"},
+        "--moderation-fail-mode",
+    ),
+    ("basic_go", {"tool_result_format": "yaml"}, "--tool-result-format"),
+    (
+        "basic_go",
+        {"tool_result_format": "json", "tool_result_max_bytes": 10},
+        "--tool-result-max-bytes",
+    ),
+    (
+        "basic_go",
+        {"circuit_breaker": True, "circuit_breaker_threshold": 0},
+        "--circuit-breaker-threshold",
+    ),
+    (
+        "basic_go",
+        {"circuit_breaker": True, "circuit_breaker_open_duration": "soon"},
+        "--circuit-breaker-open-duration",
+    ),
+    (
+        "basic_go",
+        {"circuit_breaker": True, "circuit_breaker_probes": 0},
+        "--circuit-breaker-probes",
+    ),
+    (
+        "basic_go",
+        {"localize": True, "localize_default": "english!"},
+        "--localize-default",
+    ),
+    (
+        "basic_go",
+        {"localize": True, "localize_languages": ["en", "zh_CN"]},
+        "--localize-languages",
+    ),
+    (
+        "basic_go",
+        {"with_thinking_bench": True, "thinking_bench_runs": 0},
+        "--thinking-bench-runs",
+    ),
+    ("basic_go", {"preprocess": ["trim", "spellcheck"]}, "--preprocess 'spellcheck'"),
+    ("basic_go", {"tenant_header": "X Tenant"}, "--tenant-header"),
+    ("a2a_go", {"tenant_header": "X-Tenant-ID"}, "--tenant-header"),
+    (
+        "basic_go",
+        {"tool_progress": True, "tool_progress_interval": "often"},
+        "--tool-progress",
+    ),
+    ("a2a_go", {"tool_progress": True}, "--tool-progress"),
+    (
+        "basic_go",
+        {"budget_ceiling": 0.0, "budget_fallback_model": "m"},
+        "greater than 0",
+    ),
+    ("basic_go", {"budget_ceiling": 0.1}, "requires --budget-fallback-model"),
+    ("basic_go", {"budget_fallback_model": "m"}, "require --budget-ceiling"),
+    (
+        "basic_go",
+        {"budget_ceiling": 0.1, "budget_fallback_model": "unknown"},
+        "no price",
+    ),
+]
+
+
+@pytest.mark.parametrize("template, options, message", INVALID_OPTIONS)
+def test_invalid_options_rejected(
+    tmp_path: Path, executor, template: str, options: dict, message: str
+) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template=template,
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(**options),
+    )
+
+    assert not result.success
+    assert result.error_code == "INVALID_CONFIG"
+    assert message in result.error, result.error
+    assert list(tmp_path.iterdir()) == []
+
+
+@pytest.mark.parametrize(
+    "options, flags",
+    [
+        ({"max_steps": 5}, "--max-steps"),
+        ({"prompt_lint": True}, "--prompt-lint"),
+        ({"prompt_fragments": ["a.md"]}, "--prompt-fragments"),
+        ({"layout": "monorepo", "workspace": "svc"}, "--layout, --workspace"),
+    ],
+)
+def test_from_agent_rejects_template_options(
+    tmp_path: Path, executor, options: dict, flags: str
+) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    agent_file = tmp_path / "my_agent.py"
+    agent_file.write_text('from veadk import Agent\n\nagent = Agent(name="a")\n')
+    out = tmp_path / "out"
+
+    result = executor.init_from_agent_file(
+        project_name="demo",
+        agent_file_path=str(agent_file),
+        directory=str(out),
+        scaffold_options=ScaffoldOptions(**options),
+    )
+
+    assert not result.success
+    assert result.error_code == "INVALID_CONFIG"
+    assert result.error.endswith(f"--from-agent: {flags}"), result.error
+    assert not out.exists()
+
+
+def test_from_agent_accepts_default_options(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    agent_file = tmp_path / "my_agent.py"
+    agent_file.write_text('from veadk import Agent\n\nagent = Agent(name="a")\n')
+
+    result = executor.init_from_agent_file(
+        project_name="demo",
+        agent_file_path=str(agent_file),
+        directory=str(tmp_path / "out"),
+        scaffold_options=ScaffoldOptions(),
+    )
+
+    assert result.success, result.error
+
+
+def test_no_feature_files_with_schema_endpoint_off(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(schema_endpoint="off"),
+    )
+
+    assert result.success
+    assert not (tmp_path / "features.go").exists()
+    assert "applyFeatures" not in (tmp_path / "agent.go").read_text(encoding="utf-8")
+
+
+def test_empty_input_default_response_rendered(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="a2a_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(
+            on_empty_input="default-response",
+            empty_input_response='Say "hi" first.',
+        ),
+    )
+
+    assert result.success
+    assert "empty_input.go" in result.created_files
+    guard = (tmp_path / "empty_input.go").read_text(encoding="utf-8")
+    assert 'const emptyInputResponse = "Say \\"hi\\" first."' in guard
+    assert "TurnComplete: true" in guard
+    assert "applyFeatures(cfg)" in (tmp_path / "agent.go").read_text(encoding="utf-8")
+    assert "emptyInputGuard" in (tmp_path / "features.go").read_text(encoding="utf-8")
+
+
+def test_monorepo_layout_registers_workspace_member(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    (tmp_path / "go.mod").write_text(
+        "module github.com/acme/platform\n\ngo 1.24\n", encoding="utf-8"
+    )
+    (tmp_path / "go.work").write_text(
+        "go 1.24\n\nuse (\n\t.\n)\n", encoding="utf-8"
+    )
+
+    result = executor.init_project(
+        project_name="faq",
+        template="basic_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(layout="monorepo"),
+    )
+
+    assert result.success
+    service_dir = tmp_path / "services" / "faq"
+    assert Path(result.project_path) == service_dir
+    assert (service_dir / "agent.go").exists()
+    go_mod = (service_dir / "go.mod").read_text(encoding="utf-8")
+    assert go_mod.startswith("module github.com/acme/platform/services/faq\n")
+    work = (tmp_path / "go.work").read_text(encoding="utf-8")
+    assert work == "go 1.24.4\n\nuse (\n\t.\n\t./services/faq\n)\n"
+
+
+def test_monorepo_layout_creates_workspace(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="faq",
+        template="basic_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(layout="monorepo"),
+    )
+
+    assert result.success
+    go_mod = (tmp_path / "services" / "faq" / "go.mod").read_text(encoding="utf-8")
+    assert go_mod.startswith("module services/faq\n")
+    work = (tmp_path / "go.work").read_text(encoding="utf-8")
+    assert work == "go 1.24.4\n\nuse ./services/faq\n"
+
+
+def test_prompt_lint_strict_blocks_init(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(tmp_path),
+        system_prompt="Do {{ thing }}",
+        scaffold_options=ScaffoldOptions(prompt_lint_strict=True),
+    )
+
+    assert not result.success
+    assert result.error_code == "INVALID_CONFIG"
+    assert not (tmp_path / "agent.go").exists()
+
+
+def test_prompt_lint_warns_without_blocking(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(tmp_path),
+        system_prompt="Do {{ thing }}",
+        scaffold_options=ScaffoldOptions(prompt_lint=True),
+    )
+
+    assert result.success
+
+
+def test_fallback_status_adds_gateway(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(
+            fallback_response="Sorry, try again later.", fallback_status=503
+        ),
+    )
+
+    assert result.success
+    fallback = (tmp_path / "fallback.go").read_text(encoding="utf-8")
+    assert "const fallbackStatus = 503" in fallback
+    assert "gateway.go" in result.created_files
+    main = (tmp_path / "main.go").read_text(encoding="utf-8")
+    assert "Port:         appPort," in main
+    assert "runGateway(ctx)" in main
+
+
+def test_prompt_fragments_composed_into_instruction(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    persona = tmp_path / "persona.md"
+    persona.write_text("You are a support agent.\n", encoding="utf-8")
+    task = tmp_path / "task.md"
+    task.write_text("Answer in a ```json``` block.\n", encoding="utf-8")
+    project_dir = tmp_path / "demo"
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(project_dir),
+        scaffold_options=ScaffoldOptions(
+            prompt_fragments=[str(persona), str(task)],
+            prompt_fragment_separator="\n---\n",
+        ),
+    )
+
+    assert result.success
+    agent = (project_dir / "agent.go").read_text(encoding="utf-8")
+    assert f"// Composed from prompt fragments: {persona}, {task}" in agent
+    assert (
+        'instruction = "You are a support agent.\\n---\\nAnswer in a ```json``` block."'
+        in agent
+    )
+
+
+def test_prompt_fragments_rendered_as_python_literal(
+    tmp_path: Path, executor
+) -> None:
+    import ast
+
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    fragment = tmp_path / "style.md"
+    fragment.write_text(
+        'Quote with """triple""" quotes and end with "', encoding="utf-8"
+    )
+    project_dir = tmp_path / "demo"
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic",
+        directory=str(project_dir),
+        scaffold_options=ScaffoldOptions(prompt_fragments=[str(fragment)]),
+    )
+
+    assert result.success
+    agent = (project_dir / "demo.py").read_text(encoding="utf-8")
+    module = ast.parse(agent)
+    prompts = [
+        node.value.value
+        for node in module.body
+        if isinstance(node, ast.Assign)
+        and getattr(node.targets[0], "id", None) == "system_prompt"
+    ]
+    assert prompts == ['Quote with """triple""" quotes and end with "']
+
+
+def test_missing_prompt_fragment_rejected(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(
+            prompt_fragments=[str(tmp_path / "missing.md")]
+        ),
+    )
+
+    assert not result.success
+    assert result.error_code == "FILE_NOT_FOUND"
+    assert "missing.md" in result.error
+
+
+def test_schema_endpoint_on_mounts_route(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(schema_endpoint="on"),
+    )
+
+    assert result.success
+    assert "schema.go" in result.created_files
+    gateway = (tmp_path / "gateway.go").read_text(encoding="utf-8")
+    assert 'mux.HandleFunc("/schema", handleSchema)' in gateway
+    features = (tmp_path / "features.go").read_text(encoding="utf-8")
+    assert "registerToolSchema(cfg.Tools)" in features
+
+
+@pytest.mark.parametrize(
+    "options, generated",
+    [
+        ({"tool_registry_url": "https://tools.example.com/v1/tools"}, True),
+        (
+            {
+                "tool_registry_url": "https://tools.example.com",
+                "schema_endpoint": "off",
+            },
+            False,
+        ),
+        # Tools can also come from the sample or be added to agent.go later.
+        ({}, True),
+    ],
+)
+def test_schema_endpoint_auto_follows_tools(
+    tmp_path: Path, executor, options, generated
+) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(**options),
+    )
+
+    assert result.success, result.error
+    assert ("schema.go" in result.created_files) == generated
+    if generated:
+        gateway = (tmp_path / "gateway.go").read_text(encoding="utf-8")
+        assert "if len(toolDeclarations) > 0 {" in gateway
+
+
+def test_inject_clock_generates_clock_and_test(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(inject_clock=True, schema_endpoint="off"),
+    )
+
+    assert result.success
+    assert {"clock.go", "clock_test.go"} <= set(result.created_files)
+    features = (tmp_path / "features.go").read_text(encoding="utf-8")
+    assert "cfg.InstructionProvider = clockInstruction(cfg.Instruction)" in features
+    assert not (tmp_path / "gateway.go").exists()
+
+
+def test_compress_installs_gzip_middleware(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(compress="gzip", compress_min_size=2048),
+    )
+
+    assert result.success
+    compress = (tmp_path / "compress.go").read_text(encoding="utf-8")
+    assert "const compressMinSize = 2048" in compress
+    gateway = (tmp_path / "gateway.go").read_text(encoding="utf-8")
+    assert "handler = withCompression(handler)" in gateway
+
+
+def test_with_replay_adds_export_and_replay_routes(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(with_replay=True),
+    )
+
+    assert result.success
+    assert "replay.go" in result.created_files
+    gateway = (tmp_path / "gateway.go").read_text(encoding="utf-8")
+    assert 'mux.HandleFunc("GET /sessions/{id}/export", handleExport)' in gateway
+    assert 'mux.HandleFunc("POST /replay", handleReplay)' in gateway
+
+
+def test_with_attachments_rendered(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(
+            with_attachments=True,
+            attachment_max_bytes=1024,
+            attachment_types=["Text/*", "application/pdf", "text/*"],
+        ),
+    )
+
+    assert result.success
+    attachments = (tmp_path / "attachments.go").read_text(encoding="utf-8")
+    assert "maxAttachmentBytes = 1024" in attachments
+    assert '\t"text/*",\n\t"application/pdf",\n}' in attachments
+    gateway = (tmp_path / "gateway.go").read_text(encoding="utf-8")
+    assert "upstreamHandler = withAttachments(upstreamHandler)" in gateway
+    features = (tmp_path / "features.go").read_text(encoding="utf-8")
+    assert "append(cfg.BeforeModelCallbacks, attachFiles)" in features
+
+
+def test_idempotency_rendered(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(
+            idempotency=True, idempotency_ttl="24h", tenant_header="X-Tenant-ID"
+        ),
+    )
+
+    assert result.success
+    idempotency = (tmp_path / "idempotency.go").read_text(encoding="utf-8")
+    assert "idempotencyTTL = 86400 * time.Second" in idempotency
+    assert "http.MaxBytesReader(w, r.Body, maxIdempotentRequestBytes)" in idempotency
+    assert "maxIdempotentRequestBytes = 1 << 20" in idempotency
+    assert '"text/event-stream")' in idempotency
+    assert "key = tenantScoped(r.Header.Get(tenantHeader), key)" in idempotency
+    gateway = (tmp_path / "gateway.go").read_text(encoding="utf-8")
+    assert "upstreamHandler = withIdempotency(upstreamHandler)" in gateway
+
+
+def test_readonly_fs_routes_logs_to_stdout(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(readonly_fs=True, inject_clock=True),
+    )
+
+    assert result.success
+    readonly = (tmp_path / "readonly_fs.go").read_text(encoding="utf-8")
+    assert 'const writableDir = "/tmp"' in readonly
+    clock = (tmp_path / "clock.go").read_text(encoding="utf-8")
+    assert "clockLogWriter{out: os.Stdout}" in clock
+
+
+def test_with_loadtest_matches_template_protocol(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="a2a_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(
+            with_loadtest=True, loadtest_vus=25, loadtest_duration="2m"
+        ),
+    )
+
+    assert result.success
+    script = (tmp_path / "loadtest.js").read_text(encoding="utf-8")
+    assert "vus: Number(__ENV.VUS || 25)" in script
+    assert 'duration: __ENV.DURATION || "2m"' in script
+    assert 'method: "message/send"' in script
+
+
+def test_loadtest_sends_tenant_and_signature(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(
+            with_loadtest=True,
+            tenant_header="X-Tenant-ID",
+            verify_signature="hmac-sha256",
+            signature_header="X-Body-Signature",
+            signature_secret_env="BODY_SECRET",
+        ),
+    )
+
+    assert result.success, result.error
+    script = (tmp_path / "loadtest.js").read_text(encoding="utf-8")
+    assert 'headers["X-Tenant-ID"] = TENANT;' in script
+    assert "const SIGNATURE_SECRET = __ENV.BODY_SECRET;" in script
+    assert 'headers["X-Body-Signature"] = crypto.hmac("sha256",' in script
+
+
+def test_path_prefix_strips_prefix_in_gateway(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(
+            path_prefix="/agents/faq", with_loadtest=True
+        ),
+    )
+
+    assert result.success
+    gateway = (tmp_path / "gateway.go").read_text(encoding="utf-8")
+    assert 'pathPrefix = "/agents/faq"' in gateway
+    assert "handler = http.StripPrefix(pathPrefix, handler)" in gateway
+    assert "turns.LoadOrStore(sessionID, t); busy" in gateway
+    script = (tmp_path / "loadtest.js").read_text(encoding="utf-8")
+    assert '"http://localhost:8000/agents/faq"' in script
+
+
+def test_prompt_version_served_and_stamped(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="a2a_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(prompt_version="v2-concise"),
+    )
+
+    assert result.success
+    version = (tmp_path / "prompt_version.go").read_text(encoding="utf-8")
+    assert 'promptVersion = "v2-concise"' in version
+    gateway = (tmp_path / "gateway.go").read_text(encoding="utf-8")
+    assert 'mux.HandleFunc("GET /version", handleVersion)' in gateway
+    assert "handler = withPromptVersion(handler)" in gateway
+
+
+def test_config_driven_serves_reload(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(tmp_path),
+        system_prompt='Say "hi".\nThen stop.',
+        model_name="doubao-seed-1-6-250615",
+        scaffold_options=ScaffoldOptions(
+            config_driven=True, reload_token_env="OPS_TOKEN"
+        ),
+    )
+
+    assert result.success, result.error
+    config = (tmp_path / "config.yaml").read_text(encoding="utf-8")
+    assert 'instruction: "Say \\"hi\\".\\nThen stop."' in config
+    assert 'name: "doubao-seed-1-6-250615"' in config
+    live = (tmp_path / "config_driven.go").read_text(encoding="utf-8")
+    assert 'reloadTokenEnv = "OPS_TOKEN"' in live
+    features = (tmp_path / "features.go").read_text(encoding="utf-8")
+    assert "cfg.InstructionProvider = liveInstruction" in features
+    gateway = (tmp_path / "gateway.go").read_text(encoding="utf-8")
+    assert 'mux.HandleFunc("POST /reload", handleReload)' in gateway
+    assert "t.config = currentConfig.Load()" in gateway
+
+
+def test_config_history_served(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(
+            config_driven=True, config_history=True, config_history_limit=20
+        ),
+    )
+
+    assert result.success, result.error
+    history = (tmp_path / "config_history.go").read_text(encoding="utf-8")
+    assert "configHistoryLimit = 20" in history
+    assert "err = writeConfigHistory(configHistory)" in history
+    live = (tmp_path / "config_driven.go").read_text(encoding="utf-8")
+    assert 'recordConfigChange(prev, c, "reload")' in live
+    assert "recordStartupConfig(c)" in live
+    gateway = (tmp_path / "gateway.go").read_text(encoding="utf-8")
+    assert 'mux.HandleFunc("GET /config/history", handleConfigHistory)' in gateway
+
+
+def test_generation_params_rendered_into_model_config(
+    tmp_path: Path, executor
+) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(stop=["\n\n", "END"], presence_penalty=0.3),
+    )
+
+    assert result.success
+    agent = (tmp_path / "agent.go").read_text(encoding="utf-8")
+    assert 'cfg.ModelExtraConfig["stop"] = []string{"\\n\\n", "END"}' in agent
+    assert 'cfg.ModelExtraConfig["presence_penalty"] = 0.3' in agent
+    assert "frequency_penalty" not in agent
+
+
+def test_tool_registry_loaded_at_startup(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(
+            tool_registry_url="https://tools.example.com/v1/tools",
+            tool_registry_policy="skip",
+        ),
+    )
+
+    assert result.success
+    registry = (tmp_path / "tool_registry.go").read_text(encoding="utf-8")
+    assert 'defaultToolRegistryURL = "https://tools.example.com/v1/tools"' in registry
+    assert "toolRegistryAttempts = 3" in registry
+    assert "log.Fatalf" not in registry
+    features = (tmp_path / "features.go").read_text(encoding="utf-8")
+    assert "cfg.Tools = append(cfg.Tools, loadRegistryTools()...)" in features
+
+
+def test_model_call_timeout_rendered_as_go_duration(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(model_call_timeout="1m30s"),
+    )
+
+    assert result.success
+    timeout = (tmp_path / "model_timeout.go").read_text(encoding="utf-8")
+    assert "const modelCallTimeout = 90 * time.Second" in timeout
+    assert "context.WithTimeoutCause(" in timeout
+    assert "t.setStatus(http.StatusGatewayTimeout)" in timeout
+    features = (tmp_path / "features.go").read_text(encoding="utf-8")
+    assert "useModelCallTimeout()" in features
+    assert "append(cfg.AfterModelCallbacks, noteModelTimeout)" in features
+    gateway = (tmp_path / "gateway.go").read_text(encoding="utf-8")
+    assert "proxy.ErrorHandler" not in gateway
+
+
+def test_model_call_timeout_leaves_status_to_fallback(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(
+            model_call_timeout="45s", fallback_response="Try again later."
+        ),
+    )
+
+    assert result.success
+    timeout = (tmp_path / "model_timeout.go").read_text(encoding="utf-8")
+    assert "setStatus" not in timeout
+
+
+def test_verify_signature_uses_configured_header_and_secret(
+    tmp_path: Path, executor
+) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(
+            verify_signature="hmac-sha256",
+            signature_header="X-Hub-Signature-256",
+            signature_secret_env="WEBHOOK_SECRET",
+        ),
+    )
+
+    assert result.success
+    signature = (tmp_path / "signature.go").read_text(encoding="utf-8")
+    assert 'signatureHeader = "X-Hub-Signature-256"' in signature
+    assert 'signatureSecretEnv = "WEBHOOK_SECRET"' in signature
+    gateway = (tmp_path / "gateway.go").read_text(encoding="utf-8")
+    assert "handler, err = withSignature(handler)" in gateway
+    assert "bypassing the gateway's request checks" in gateway
+
+
+def test_exposed_app_only_logged_without_request_checks(
+    tmp_path: Path, executor
+) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(compress="gzip"),
+    )
+
+    assert result.success
+    gateway = (tmp_path / "gateway.go").read_text(encoding="utf-8")
+    assert "if err := checkAppExposure(ctx); err != nil {" in gateway
+    assert "requests there skip the gateway" in gateway
+    assert "bypassing the gateway's request checks" not in gateway
+
+
+def test_grpc_transport_fails_on_exposed_app(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(transport="grpc"),
+    )
+
+    assert result.success
+    gateway = (tmp_path / "gateway.go").read_text(encoding="utf-8")
+    assert "although the transport is gRPC only" in gateway
+    assert "requests there skip the gateway" not in gateway
+
+
+def test_warmup_serves_readyz_outside_path_prefix(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(
+            warmup=True, warmup_timeout="1m", path_prefix="/agents/demo"
+        ),
+    )
+
+    assert result.success
+    warmup = (tmp_path / "warmup.go").read_text(encoding="utf-8")
+    assert "const warmupTimeout = 60 * time.Second" in warmup
+    assert "/invoke" in warmup
+    gateway = (tmp_path / "gateway.go").read_text(encoding="utf-8")
+    assert gateway.index("http.StripPrefix") < gateway.index('"GET /readyz"')
+    assert "go warmup(ctx)" in gateway
+
+
+def test_pprof_runs_on_separate_address(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(
+            pprof=True, pprof_token_env="PPROF_TOKEN", schema_endpoint="off"
+        ),
+    )
+
+    assert result.success
+    pprof = (tmp_path / "pprof.go").read_text(encoding="utf-8")
+    assert 'const pprofAddr = "127.0.0.1:6060"' in pprof
+    assert "withBearerToken(handler, token)" in pprof
+    assert "runPprof(ctx)" in (tmp_path / "main.go").read_text(encoding="utf-8")
+    assert not (tmp_path / "gateway.go").exists()
+
+
+def test_response_headers_override_secure_defaults(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(
+            secure_headers=True, response_headers=["cache-control: no-cache"]
+        ),
+    )
+
+    assert result.success
+    headers = (tmp_path / "response_headers.go").read_text(encoding="utf-8")
+    assert '{"X-Content-Type-Options", "nosniff"}' in headers
+    assert '{"cache-control", "no-cache"}' in headers
+    assert "no-store" not in headers
+    gateway = (tmp_path / "gateway.go").read_text(encoding="utf-8")
+    assert "handler = withResponseHeaders(handler)" in gateway
+
+
+def test_context_headers_render_mapping_config(tmp_path: Path, executor) -> None:
+    import json
+
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(
+            context_headers=["X-Tenant-Id=tenant", "X-Region"]
+        ),
+    )
+
+    assert result.success
+    config = json.loads((tmp_path / "request_context.json").read_text(encoding="utf-8"))
+    assert config["headers"] == [
+        {"header": "X-Tenant-Id", "label": "tenant"},
+        {"header": "X-Region", "label": "X-Region"},
+    ]
+    features = (tmp_path / "features.go").read_text(encoding="utf-8")
+    assert "injectRequestContext" in features
+
+
+def test_parallel_tools_on_generates_bounded_executor(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="a2a_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(parallel_tools="on", parallel_tools_limit=2),
+    )
+
+    assert result.success
+    parallel = (tmp_path / "parallel_tools.go").read_text(encoding="utf-8")
+    assert "const parallelToolsLimit = 2" in parallel
+    features = (tmp_path / "features.go").read_text(encoding="utf-8")
+    assert "runToolBatch" in features
+    assert "registerParallelTools(cfg.Tools)" in features
+
+
+def test_parallel_tools_off_generates_nothing(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(parallel_tools="off"),
+    )
+
+    assert result.success
+    assert not (tmp_path / "parallel_tools.go").exists()
+
+
+def test_redact_logs_renders_patterns_into_access_log(
+    tmp_path: Path, executor
+) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    patterns = tmp_path / "redact.txt"
+    patterns.write_text("[\\w.+-]+@[\\w.-]+\n", encoding="utf-8")
+    out = tmp_path / "out"
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(out),
+        scaffold_options=ScaffoldOptions(access_log=True, redact_logs=str(patterns)),
+    )
+
+    assert result.success
+    access_log = (out / "access_log.go").read_text(encoding="utf-8")
+    assert 'regexp.MustCompile("[\\\\w.+-]+@[\\\\w.-]+")' in access_log
+    gateway = (out / "gateway.go").read_text(encoding="utf-8")
+    assert "handler = withAccessLog(handler)" in gateway
+
+
+@pytest.mark.parametrize(
+    "backend, store", [("inmemory", "newMemoryStore"), ("redis", "newRedisStore")]
+)
+def test_prompt_cache_backends(
+    tmp_path: Path, executor, backend: str, store: str
+) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(prompt_cache=backend, prompt_cache_ttl="1h"),
+    )
+
+    assert result.success
+    cache = (tmp_path / "prompt_cache.go").read_text(encoding="utf-8")
+    assert f"var promptCache promptCacheStore = {store}()" in cache
+    assert "promptCacheTTL = 3600 * time.Second" in cache
+    features = (tmp_path / "features.go").read_text(encoding="utf-8")
+    assert "lookupPromptCache" in features and "storePromptCache" in features
+    assert "append(cfg.AfterAgentCallbacks, forgetPromptCacheKey)" in features
+    go_mod = (tmp_path / "go.mod").read_text(encoding="utf-8")
+    go_sum = (tmp_path / "go.sum").read_text(encoding="utf-8")
+    if backend == "redis":
+        assert "\tgithub.com/redis/go-redis/v9 v9.7.3\n" in go_mod
+        assert "github.com/redis/go-redis/v9 v9.7.3 h1:" in go_sum
+        assert "github.com/cespare/xxhash/v2 v2.2.0/go.mod h1:" in go_sum
+    else:
+        assert "go-redis" not in go_mod and "go-redis" not in go_sum
+
+
+def test_scaffold_tool_generates_package(tmp_path: Path, executor) -> None:
+    result = executor.scaffold_tool(
+        name="get_weather", module="github.com/org/tools", directory=str(tmp_path)
+    )
+
+    assert result.success, result.error
+    assert result.metadata["import_path"] == "github.com/org/tools/getweather"
+    assert (tmp_path / "go.mod").read_text(encoding="utf-8").startswith(
+        "module github.com/org/tools\n"
+    )
+    source = (tmp_path / "getweather" / "getweather.go").read_text(encoding="utf-8")
+    assert "package getweather" in source
+    assert 'Name:        "get_weather"' in source
+    assert (tmp_path / "getweather" / "getweather_test.go").exists()
+
+    # A second tool joins the existing module and takes its path from go.mod.
+    second = executor.scaffold_tool(name="geocode", directory=str(tmp_path))
+    assert second.success, second.error
+    assert second.created_files == ["geocode/geocode.go", "geocode/geocode_test.go"]
+
+    mismatch = executor.scaffold_tool(
+        name="lookup", module="github.com/other/tools", directory=str(tmp_path)
+    )
+    assert not mismatch.success
+    assert "declares module github.com/org/tools" in mismatch.error
+
+
+def test_tool_package_rendered_into_agent(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="a2a_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(
+            tool_package=["github.com/org/tools/geocode", "example.com/search"]
+        ),
+    )
+
+    assert result.success
+    packages = (tmp_path / "tool_packages.go").read_text(encoding="utf-8")
+    assert '\t"example.com/search"\n\t"github.com/org/tools/geocode"\n' in packages
+    assert "\t\tgeocode.New,\n\t\tsearch.New,\n" in packages
+    features = (tmp_path / "features.go").read_text(encoding="utf-8")
+    assert "cfg.Tools = append(cfg.Tools, packageTools()...)" in features
+
+
+def test_scaffold_tool_no_network_pins_bundled_versions(
+    tmp_path: Path, executor
+) -> None:
+    from agentkit.toolkit.utils.go_features import BUNDLED_GO_PINS_DIR
+
+    result = executor.scaffold_tool(
+        name="geocode",
+        module="github.com/org/tools",
+        directory=str(tmp_path),
+        no_network=True,
+    )
+
+    assert result.success, result.error
+    assert result.metadata["pinned"]
+    assert result.created_files[-2:] == ["go.mod", "go.sum"]
+    go_mod = (tmp_path / "go.mod").read_text(encoding="utf-8")
+    assert go_mod.startswith("module github.com/org/tools\n")
+    assert "google.golang.org/adk v0.3.1-0.20251223085414-415e39855752" in go_mod
+    bundled = (BUNDLED_GO_PINS_DIR / "go.sum").read_bytes()
+    assert (tmp_path / "go.sum").read_bytes() == bundled
+
+
+def test_model_override_rendered(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(
+            allow_model_override=["doubao-seed-1-6-250615", "deepseek-v3-250324"]
+        ),
+    )
+
+    assert result.success
+    override = (tmp_path / "model_override.go").read_text(encoding="utf-8")
+    assert '\t"doubao-seed-1-6-250615",\n\t"deepseek-v3-250324",\n' in override
+    gateway = (tmp_path / "gateway.go").read_text(encoding="utf-8")
+    assert "upstreamHandler = withModelOverride(upstreamHandler)" in gateway
+    features = (tmp_path / "features.go").read_text(encoding="utf-8")
+    assert "append(cfg.BeforeModelCallbacks, overrideModel)" in features
+
+
+def test_max_steps_rendered(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="a2a_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(max_steps=8),
+    )
+
+    assert result.success
+    max_steps = (tmp_path / "max_steps.go").read_text(encoding="utf-8")
+    assert "const defaultMaxSteps = 8" in max_steps
+    assert 'os.Getenv("AGENT_MAX_STEPS")' in max_steps
+    features = (tmp_path / "features.go").read_text(encoding="utf-8")
+    assert "append(cfg.BeforeModelCallbacks, limitSteps)" in features
+    assert "append(cfg.AfterAgentCallbacks, forgetSteps)" in features
+
+
+@pytest.mark.parametrize(
+    "transport, gateway_addr",
+    [("both", 'fmt.Sprintf(":%d"'), ("grpc", 'fmt.Sprintf("127.0.0.1:%d"')],
+)
+def test_grpc_transport_rendered(
+    tmp_path: Path, executor, transport: str, gateway_addr: str
+) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(transport=transport, grpc_port=9090),
+    )
+
+    assert result.success
+    assert "service ChatService" in (tmp_path / "chat.proto").read_text(
+        encoding="utf-8"
+    )
+    grpc = (tmp_path / "grpc.go").read_text(encoding="utf-8")
+    assert "grpcPort = 9090" in grpc
+    assert "RegisterChatServiceServer(srv, chatService{" in grpc
+    stubs = (tmp_path / "chat_grpc.pb.go").read_text(encoding="utf-8")
+    assert "func RegisterChatServiceServer(" in stubs
+    gateway = (tmp_path / "gateway.go").read_text(encoding="utf-8")
+    assert f"Addr:         {gateway_addr}, gatewayPort)," in gateway
+    assert "runGRPC(ctx)" in (tmp_path / "main.go").read_text(encoding="utf-8")
+
+
+def test_grpc_stubs_match_chat_proto() -> None:
+    import re
+
+    from agentkit.toolkit.utils import go_features
+
+    proto = (go_features.FEATURES_TEMPLATE_DIR / "chat.proto.j2").read_text(
+        encoding="utf-8"
+    )
+    stubs = (go_features.FEATURES_TEMPLATE_DIR / "chat.pb.go.j2").read_text(
+        encoding="utf-8"
+    )
+    for message, body in re.findall(r"message (\w+) \{(.*?)\}", proto, re.S):
+        declared = re.findall(r"^\s*string (\w+) = (\d+);", body, re.M)
+        struct = re.search(rf"type {message} struct \{{(.*?)\n\}}", stubs, re.S)
+        assert struct, f"chat.pb.go has no {message}; regenerate it"
+        generated = re.findall(r'protobuf:"bytes,(\d+),opt,name=(\w+)', struct.group(1))
+        assert sorted((name, number) for number, name in generated) == sorted(
+            declared
+        ), f"chat.pb.go is out of date for {message}; regenerate it"
+
+
+def test_dump_config_rendered(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="a2a_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(
+            dump_config=True, max_steps=8, verify_signature="hmac-sha256"
+        ),
+    )
+
+    assert result.success
+    dump = (tmp_path / "dump_config.go").read_text(encoding="utf-8")
+    assert 'fromEnv("model.api_key", "MODEL_AGENT_API_KEY", "", true),' in dump
+    assert 'fromEnv("signature.secret", signatureSecretEnv, "", true),' in dump
+    assert '"AGENT_MAX_STEPS", fmt.Sprint(defaultMaxSteps)' in dump
+    assert 'generated("http.port", gatewayPort),' in dump
+
+
+@pytest.mark.parametrize("template", ["basic_go", "a2a_go"])
+def test_session_ttl_rendered(tmp_path: Path, executor, template: str) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template=template,
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(session_ttl="30m"),
+    )
+
+    assert result.success
+    sessions = (tmp_path / "session_ttl.go").read_text(encoding="utf-8")
+    assert "sessionTTL = 1800 * time.Second" in sessions
+    assert "transcripts.bySession" not in sessions
+    main = (tmp_path / "main.go").read_text(encoding="utf-8")
+    assert "SessionService: expiringSessionService()," in main
+
+
+def test_probe_deps_rendered(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(
+            probe_deps=True, probe_timeout="2s", prompt_cache="redis"
+        ),
+    )
+
+    assert result.success
+    probe = (tmp_path / "probe_deps.go").read_text(encoding="utf-8")
+    assert "probeTimeout = 2 * time.Second" in probe
+    assert "results = append(results, probeRedis())" in probe
+    assert "probeToolRegistry" not in probe
+    assert "moderationEndpoint" not in probe
+
+
+def test_moderation_rendered(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="a2a_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(
+            moderation_url="https://moderation.example.com/check",
+            moderation_action="annotate",
+            moderation_fail_mode="open",
+        ),
+    )
+
+    assert result.success
+    moderation = (tmp_path / "moderation.go").read_text(encoding="utf-8")
+    assert 'defaultModerationURL = "https://moderation.example.com/check"' in moderation
+    assert 'resp.CustomMetadata["moderation"] = verdicts' in moderation
+    assert "continuing unmoderated" in moderation
+    assert "moderationBlockedResponse" not in moderation
+    features = (tmp_path / "features.go").read_text(encoding="utf-8")
+    assert "append(cfg.BeforeModelCallbacks, moderateInput)" in features
+    assert "append(cfg.AfterModelCallbacks, moderateOutput)" in features
+
+
+@pytest.mark.parametrize("result_format", ["json", "text"])
+def test_tool_result_rendered(tmp_path: Path, executor, result_format: str) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="a2a_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(
+            tool_result_format=result_format, tool_result_max_bytes=4096
+        ),
+    )
+
+    assert result.success
+    serializer = (tmp_path / "tool_result.go").read_text(encoding="utf-8")
+    assert "const toolResultMaxBytes = 4096" in serializer
+    assert ("return normalized, nil" in serializer) == (result_format == "json")
+    features = (tmp_path / "features.go").read_text(encoding="utf-8")
+    assert "append(cfg.AfterToolCallbacks, normalizeToolResult)" in features
+
+
+def test_circuit_breaker_rendered(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(
+            circuit_breaker=True,
+            circuit_breaker_threshold=3,
+            circuit_breaker_open_duration="1m",
+            circuit_breaker_probes=2,
+            pprof=True,
+        ),
+    )
+
+    assert result.success
+    breaker = (tmp_path / "circuit_breaker.go").read_text(encoding="utf-8")
+    assert "breakerThreshold = 3" in breaker
+    assert "breakerOpenDuration = 60 * time.Second" in breaker
+    assert "breakerProbes = 2" in breaker
+    assert "return nil, errCircuitOpen" in breaker
+    features = (tmp_path / "features.go").read_text(encoding="utf-8")
+    assert "append(cfg.BeforeModelCallbacks, checkCircuit)" in features
+    assert "append(cfg.AfterModelCallbacks, recordCircuit)" in features
+    pprof = (tmp_path / "pprof.go").read_text(encoding="utf-8")
+    assert 'mux.Handle("/debug/vars", expvar.Handler())' in pprof
+
+
+def test_circuit_breaker_returns_fallback_response(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(
+            circuit_breaker=True, fallback_response="Try again later."
+        ),
+    )
+
+    assert result.success
+    breaker = (tmp_path / "circuit_breaker.go").read_text(encoding="utf-8")
+    assert "errCircuitOpen" not in breaker
+    assert "genai.NewContentFromText(fallbackResponse, genai.RoleModel)" in breaker
+
+
+def test_localize_rendered(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(
+            localize=True, localize_default="zh-CN", localize_languages=["en", "zh-cn"]
+        ),
+    )
+
+    assert result.success
+    localize = (tmp_path / "localize.go").read_text(encoding="utf-8")
+    assert 'const defaultLanguage = "zh-CN"' in localize
+    assert '\t"zh-CN",\n\t"en",\n}' in localize
+    features = (tmp_path / "features.go").read_text(encoding="utf-8")
+    assert "append(cfg.BeforeModelCallbacks, injectLanguage)" in features
+    assert (tmp_path / "gateway.go").exists()
+
+
+def test_thinking_bench_rendered(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="a2a_go",
+        directory=str(tmp_path),
+        system_prompt="You are a careful math tutor.",
+        scaffold_options=ScaffoldOptions(
+            with_thinking_bench=True, thinking_bench_runs=5
+        ),
+    )
+
+    assert result.success
+    bench = (tmp_path / "thinkingbench" / "main.go").read_text(encoding="utf-8")
+    assert 'flag.Int("runs", 5,' in bench
+    assert 'const instruction = "You are a careful math tutor."' in bench
+    assert 'var thinkingModes = []string{"disabled", "enabled"}' in bench
+    assert (tmp_path / "thinkingbench" / "prompts.json").exists()
+
+
+def test_preprocess_pipeline_rendered(tmp_path: Path, executor) -> None:
+    import json
+
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="a2a_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(
+            preprocess=["strip-markdown", "trim", "expand-abbreviations"]
+        ),
+    )
+
+    assert result.success
+    config = json.loads((tmp_path / "preprocess.json").read_text(encoding="utf-8"))
+    assert config["steps"] == ["strip-markdown", "trim", "expand-abbreviations"]
+    assert config["abbreviations"]
+    preprocess = (tmp_path / "preprocess.go").read_text(encoding="utf-8")
+    assert '"strip-markdown":       stripMarkdown,' in preprocess
+    features = (tmp_path / "features.go").read_text(encoding="utf-8")
+    assert "append(cfg.BeforeModelCallbacks, preprocessInput)" in features
+
+
+def test_tenant_header_scopes_sessions(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(
+            tenant_header="X-Tenant-ID",
+            access_log=True,
+            prompt_cache="inmemory",
+            with_replay=True,
+        ),
+    )
+
+    assert result.success
+    tenant = (tmp_path / "tenant.go").read_text(encoding="utf-8")
+    assert 'tenantHeader = "X-Tenant-ID"' in tenant
+    gateway = (tmp_path / "gateway.go").read_text(encoding="utf-8")
+    assert "handler = withTenant(handler)" in gateway
+    access_log = (tmp_path / "access_log.go").read_text(encoding="utf-8")
+    assert 'fmt.Sprintf(" tenant=%q", r.Header.Get(tenantHeader))' in access_log
+    cache = (tmp_path / "prompt_cache.go").read_text(encoding="utf-8")
+    assert "key = tenantScoped(tenantOf(ctx.SessionID()), key)" in cache
+    replay = (tmp_path / "replay.go").read_text(encoding="utf-8")
+    assert "sessionID = tenantScoped(r.Header.Get(tenantHeader), sessionID)" in replay
+
+
+def test_tool_progress_rendered(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(
+            tool_progress=True,
+            tool_progress_interval="2s",
+            parallel_tools="on",
+            tool_result_format="json",
+        ),
+    )
+
+    assert result.success
+    progress = (tmp_path / "tool_progress.go").read_text(encoding="utf-8")
+    assert "toolHeartbeatInterval = 2 * time.Second" in progress
+    assert "func toolProgress(ctx tool.Context) progressFunc" in progress
+    gateway = (tmp_path / "gateway.go").read_text(encoding="utf-8")
+    assert "upstreamHandler = streamToolProgress(upstreamHandler)" in gateway
+    features = (tmp_path / "features.go").read_text(encoding="utf-8")
+    # Progress callbacks run before the ones that can answer or rewrite a call.
+    assert features.index("startToolProgress") < features.index("runToolBatch")
+    assert features.index("finishToolProgress") < features.index("normalizeToolResult")
+    parallel = (tmp_path / "parallel_tools.go").read_text(encoding="utf-8")
+    assert "startToolProgress(cctx, parallelTools[call.Name], nil)" in parallel
+
+
+def test_budget_ceiling_rendered(tmp_path: Path, executor) -> None:
+    import json
+
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    pricing = tmp_path / "pricing.json"
+    pricing.write_text(
+        json.dumps(
+            {
+                "currency": "USD",
+                "models": {
+                    "big-model": {"input": 3, "output": 15},
+                    "small-model": {"input": 0.1, "output": 0.4},
+                },
+            }
+        ),
+        encoding="utf-8",
+    )
+    out = tmp_path / "out"
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(out),
+        model_name="big-model",
+        scaffold_options=ScaffoldOptions(
+            budget_ceiling=0.05,
+            budget_fallback_model="small-model",
+            budget_pricing=str(pricing),
+            prompt_cache="inmemory",
+        ),
+    )
+
+    assert result.success, result.error
+    budget = (out / "budget.go").read_text(encoding="utf-8")
+    assert "budgetCeiling = 0.05" in budget
+    assert 'budgetFallbackModel = "small-model"' in budget
+    assert 'budgetDefaultModel = "big-model"' in budget
+    table = json.loads((out / "model_pricing.json").read_text(encoding="utf-8"))
+    assert table["currency"] == "USD"
+    assert table["per_tokens"] == 1000000
+    features = (out / "features.go").read_text(encoding="utf-8")
+    # The model is settled before the cache key is computed from it.
+    assert features.index("enforceBudget") < features.index("lookupPromptCache")
+    gateway = (out / "gateway.go").read_text(encoding="utf-8")
+    assert "resp.Header.Set(budgetDowngradeHeader" in gateway
+
+
+def test_output_schema_rendered(tmp_path: Path, executor) -> None:
+    import json
+
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    schema = {"type": "object", "required": ["answer"]}
+    schema_file = tmp_path / "schema.json"
+    schema_file.write_text(json.dumps(schema), encoding="utf-8")
+    out = tmp_path / "out"
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(out),
+        scaffold_options=ScaffoldOptions(
+            output_schema=str(schema_file), output_retries=2, prompt_cache="inmemory"
+        ),
+    )
+
+    assert result.success, result.error
+    rendered = (out / "output_schema.json").read_text(encoding="utf-8")
+    assert json.loads(rendered) == schema
+    output = (out / "output_schema.go").read_text(encoding="utf-8")
+    assert "outputRetries = 2" in output
+    assert "func repairJSON(" in output
+    features = (out / "features.go").read_text(encoding="utf-8")
+    # Only replies that passed the check are cached.
+    assert features.index("checkOutput)") < features.index("storePromptCache)")
+    assert "cfg.Tools = append(cfg.Tools, outputFeedback())" in features
+
+
+def test_output_schema_without_repair_or_retries(tmp_path: Path, executor) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    schema_file = tmp_path / "schema.json"
+    schema_file.write_text('{"type": "array"}', encoding="utf-8")
+    out = tmp_path / "out"
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(out),
+        scaffold_options=ScaffoldOptions(
+            output_schema=str(schema_file), output_repair=False, output_retries=0
+        ),
+    )
+
+    assert result.success, result.error
+    output = (out / "output_schema.go").read_text(encoding="utf-8")
+    assert "repairJSON" not in output
+    assert "outputFeedback" not in output
+    assert "outputFeedback" not in (out / "features.go").read_text(encoding="utf-8")
+
+
+@pytest.mark.parametrize(
+    "schema, options, message",
+    [
+        ('{"type": "object",}', {}, "Invalid output schema"),
+        ('["answer"]', {}, "must be a non-empty JSON object"),
+        ('{"type": "dict"}', {}, "invalid type"),
+        ('{"type": "object"}', {"output_retries": 9}, "--output-retries"),
+        (None, {"output_repair": False}, "require --output-schema"),
+    ],
+)
+def test_invalid_output_schema_rejected(
+    tmp_path: Path, executor, schema, options: dict, message: str
+) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    if schema is not None:
+        schema_file = tmp_path / "schema.json"
+        schema_file.write_text(schema, encoding="utf-8")
+        options = dict(options, output_schema=str(schema_file))
+
+    result = executor.init_project(
+        project_name="demo",
+        template="basic_go",
+        directory=str(tmp_path / "out"),
+        scaffold_options=ScaffoldOptions(**options),
+    )
+
+    assert not result.success
+    assert message in result.error
+
+
+# Enables most features at once, so their templates are rendered side by side.
+MANY_FEATURES = {
+    "on_empty_input": "default-response",
+    "empty_input_response": "Ask me something.",
+    "fallback_response": "Sorry.",
+    "compress": "gzip",
+    "with_replay": True,
+    "with_attachments": True,
+    "idempotency": True,
+    "path_prefix": "/agents/faq",
+    "config_driven": True,
+    "config_history": True,
+    "tool_registry_url": "https://tools.example.com",
+    "model_call_timeout": "30s",
+    "verify_signature": "hmac-sha256",
+    "pprof": True,
+    "response_headers": ["Cache-Control: no-store"],
+    "prompt_cache": "inmemory",
+    "max_steps": 8,
+    "transport": "both",
+    "dump_config": True,
+    "session_ttl": "30m",
+    "probe_deps": True,
+    "moderation_url": "https://m.example.com",
+    "tool_result_format": "json",
+    "circuit_breaker": True,
+    "localize": True,
+    "with_thinking_bench": True,
+    "preprocess": ["trim"],
+    "tenant_header": "X-Tenant-ID",
+    "tool_progress": True,
+}
+
+
+@pytest.mark.skipif(shutil.which("gofmt") is None, reason="needs a Go toolchain")
+@pytest.mark.parametrize(
+    "template, options",
+    [
+        ("basic_go", {}),
+        ("basic_go", MANY_FEATURES),
+        ("a2a_go", {"session_ttl": "30m", "max_steps": 8}),
+    ],
+)
+def test_rendered_go_is_gofmt_clean(
+    tmp_path: Path, executor, template: str, options: dict
+) -> None:
+    from agentkit.toolkit.executors import ScaffoldOptions
+
+    result = executor.init_project(
+        project_name="demo",
+        template=template,
+        directory=str(tmp_path),
+        scaffold_options=ScaffoldOptions(**options),
+    )
+
+    assert result.success, result.error
+    unformatted = subprocess.run(
+        ["gofmt", "-l", "."], cwd=tmp_path, capture_output=True, text=True
+    )
+    assert unformatted.returncode == 0, unformatted.stderr
+    assert unformatted.stdout == "", f"gofmt would reformat: {unformatted.stdout}"
@@ -0,0 +1,58 @@
+# Copyright (c) 2026 Beijing Volcano Engine Technology Co., Ltd. and/or its affiliates.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+from __future__ import annotations
+
+
+def test_schema_export_describes_go_sample(executor) -> None:
+    result = executor.export_vars_schema("veadk_go_basic")
+
+    assert result.success, result.error
+    assert result.template == "basic_go"
+    schema = result.metadata["schema"]
+    assert schema["required"] == ["project_name"]
+    assert schema["additionalProperties"] is False
+    props = schema["properties"]
+    assert props["on_empty_input"]["enum"] == [
+        "error",
+        "default-response",
+        "passthrough",
+    ]
+    assert props["on_empty_input"]["default"] == "passthrough"
+    assert props["on_empty_input"]["x-agentkit-flag"] == "--on-empty-input"
+    assert props["preprocess"]["type"] == "array"
+    assert "lowercase" in props["preprocess"]["items"]["enum"]
+    assert props["max_steps"]["type"] == "integer"
+    assert "default" not in props["max_steps"]
+    assert props["max_steps"]["description"].startswith("Maximum model calls")
+    assert props["layout"]["enum"] == ["standalone", "monorepo"]
+
+
+def test_schema_export_only_lists_options_of_the_template(executor) -> None:
+    go_props = executor.export_vars_schema("a2a_go").metadata["schema"]["properties"]
+    py_props = executor.export_vars_schema("basic.py").metadata["schema"]["properties"]
+
+    assert "stop" in go_props
+    assert "tenant_header" not in go_props
+    assert "system_prompt" in py_props
+    assert "stop" not in py_props
+    assert "layout" not in py_props
+
+
+def test_schema_export_rejects_unknown_sample(executor) -> None:
+    result = executor.export_vars_schema("nope")
+
+    assert not result.success
+    assert result.error_code == "INVALID_CONFIG"
+    assert "basic_go" in result.error
@@ -0,0 +1,159 @@
+# Copyright (c) 2026 Beijing Volcano Engine Technology Co., Ltd. and/or its affiliates.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+import pytest
+
+
+@pytest.mark.parametrize(
+    "value, seconds, expression",
+    [
+        ("45s", 45.0, "45 * time.Second"),
+        ("1m30s", 90.0, "90 * time.Second"),
+        ("500ms", 0.5, "500 * time.Millisecond"),
+        ("1.5s", 1.5, "1500 * time.Millisecond"),
+    ],
+)
+def test_durations_convert_to_go(value, seconds, expression):
+    from agentkit.toolkit.utils.go_features import go_duration, parse_duration
+
+    assert parse_duration(value) == seconds
+    assert go_duration(value) == expression
+
+
+@pytest.mark.parametrize("value", ["", "90", "1x", "1m 30s", "s"])
+def test_malformed_durations_are_rejected(value):
+    from agentkit.toolkit.utils.go_features import parse_duration
+
+    assert parse_duration(value) is None
+
+
+def test_response_headers_override_secure_defaults_case_insensitively():
+    from agentkit.toolkit.executors import ScaffoldOptions
+    from agentkit.toolkit.utils.go_features import response_header_values
+
+    options = ScaffoldOptions(
+        secure_headers=True,
+        response_headers=["x-frame-options: SAMEORIGIN", "Bad Header: x"],
+    )
+
+    headers = dict(response_header_values(options))
+    assert headers["x-frame-options"] == "SAMEORIGIN"
+    assert "X-Frame-Options" not in headers
+    assert headers["X-Content-Type-Options"] == "nosniff"
+    assert "Bad Header" not in headers
+
+
+def test_header_and_language_lists_are_normalized():
+    from agentkit.toolkit.executors import ScaffoldOptions
+    from agentkit.toolkit.utils.go_features import (
+        context_header_values,
+        localize_language_values,
+    )
+
+    options = ScaffoldOptions(
+        context_headers=["X-Tenant-Id=tenant", "X-Region"],
+        localize_default="zh-CN",
+        localize_languages=["en", "ZH-cn"],
+    )
+
+    assert context_header_values(options) == [
+        ("X-Tenant-Id", "tenant"),
+        ("X-Region", "X-Region"),
+    ]
+    assert localize_language_values(options) == ["zh-CN", "en"]
+
+
+def test_http_features_enable_the_gateway():
+    from agentkit.toolkit.executors import ScaffoldOptions
+    from agentkit.toolkit.utils.go_features import enabled_features
+
+    def names(**kwargs):
+        options = ScaffoldOptions(schema_endpoint="off", **kwargs)
+        return [feature.name for feature in enabled_features(options)]
+
+    assert names() == []
+    assert names(inject_clock=True) == ["clock"]
+    assert names(compress="gzip") == ["compress", "gateway"]
+    assert names(fallback_response="Sorry.") == ["fallback"]
+    assert names(fallback_response="Sorry.", fallback_status=503) == [
+        "fallback",
+        "gateway",
+    ]
+
+
+def test_only_the_redis_cache_adds_go_requirements():
+    from agentkit.toolkit.executors import ScaffoldOptions
+    from agentkit.toolkit.utils.go_features import REDIS_GO_REQUIRES, go_requirements
+
+    assert go_requirements(ScaffoldOptions(prompt_cache="redis")) == list(
+        REDIS_GO_REQUIRES
+    )
+    assert go_requirements(ScaffoldOptions(prompt_cache="inmemory")) == []
+
+
+@pytest.mark.parametrize(
+    "options, message",
+    [
+        (
+            {"config_driven": True, "inject_clock": True},
+            "--config-driven cannot be combined with --inject-clock.",
+        ),
+        (
+            {"config_driven": True, "transport": "grpc"},
+            "--config-driven serves POST /reload and needs --transport http or both.",
+        ),
+        ({"config_history": True}, "--config-history requires --config-driven."),
+        (
+            {"output_retries": 2},
+            "--no-output-repair and --output-retries require --output-schema.",
+        ),
+        (
+            {"model_call_timeout": "2m"},
+            "--model-call-timeout must be shorter than the HTTP write timeout (120s).",
+        ),
+        (
+            {"pprof": True, "pprof_addr": "127.0.0.1:8000"},
+            "--pprof-addr must not use the agent ports",
+        ),
+        (
+            {"tool_package": ["github.com/a/tools/geo", "github.com/b/tools/geo"]},
+            "clashes with another package named 'geo'",
+        ),
+    ],
+)
+def test_conflicting_options_are_rejected(options, message):
+    from agentkit.toolkit.executors import ScaffoldOptions
+    from agentkit.toolkit.utils.go_features import validate_options
+
+    error = validate_options(ScaffoldOptions(**options))
+    assert error is not None and message in error, error
+
+
+def test_default_options_are_valid():
+    from agentkit.toolkit.executors import ScaffoldOptions
+    from agentkit.toolkit.utils.go_features import validate_options
+
+    assert validate_options(ScaffoldOptions()) is None
+
+
+def test_template_restricted_feature_names_its_templates():
+    from agentkit.toolkit.executors import ScaffoldOptions
+    from agentkit.toolkit.utils.go_features import unsupported_feature_error
+
+    options = ScaffoldOptions(model_call_timeout="45s")
+
+    assert unsupported_feature_error(options, "basic_go") is None
+    assert unsupported_feature_error(options, "a2a_go") == (
+        "Template 'a2a_go' does not support --model-call-timeout (supported: basic_go)"
+    )
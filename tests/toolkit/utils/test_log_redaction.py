@@ -0,0 +1,37 @@
+# Copyright (c) 2026 Beijing Volcano Engine Technology Co., Ltd. and/or its affiliates.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+from pathlib import Path
+
+import pytest
+
+
+def test_patterns_skip_blank_lines_and_comments(tmp_path: Path):
+    from agentkit.toolkit.utils.log_redaction import load_redact_patterns
+
+    path = tmp_path / "redact.txt"
+    path.write_text("# emails\n[\\w.+-]+@[\\w.-]+\n\n1[3-9]\\d{9}\n", encoding="utf-8")
+
+    assert load_redact_patterns(str(path)) == [r"[\w.+-]+@[\w.-]+", r"1[3-9]\d{9}"]
+
+
+@pytest.mark.parametrize("pattern", [r"(?<=card )\d+", r"(\w+) \1", "[a-z"])
+def test_patterns_go_cannot_compile_are_rejected(tmp_path: Path, pattern: str):
+    from agentkit.toolkit.utils.log_redaction import load_redact_patterns
+
+    path = tmp_path / "redact.txt"
+    path.write_text(pattern + "\n", encoding="utf-8")
+
+    with pytest.raises(ValueError, match="redact.txt:1"):
+        load_redact_patterns(str(path))
@@ -0,0 +1,67 @@
+# Copyright (c) 2026 Beijing Volcano Engine Technology Co., Ltd. and/or its affiliates.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+
+def test_well_formed_prompt_has_no_warnings():
+    from agentkit.toolkit.utils.prompt_lint import lint_prompt
+
+    prompt = "You are a helpful customer support agent. Answer billing questions politely."
+    assert lint_prompt(prompt) == []
+
+
+def test_chinese_role_statement_is_recognized():
+    from agentkit.toolkit.utils.prompt_lint import lint_prompt
+
+    assert lint_prompt("你是一个专业的客服助手，请礼貌地回答用户关于账单的问题。") == []
+
+
+def test_short_prompt_without_role_is_flagged():
+    from agentkit.toolkit.utils.prompt_lint import lint_prompt
+
+    warnings = lint_prompt("Answer briefly.")
+    assert any("very short" in w for w in warnings)
+    assert any("role statement" in w for w in warnings)
+
+
+def test_unresolved_placeholders_are_flagged():
+    from agentkit.toolkit.utils.prompt_lint import lint_prompt
+
+    warnings = lint_prompt(
+        "You are an assistant for {{ company_name }}. Contact ${SUPPORT_TEAM} if needed."
+    )
+    assert any("template expression" in w for w in warnings)
+    assert any("variable reference" in w for w in warnings)
+
+
+def test_personal_data_is_flagged():
+    from agentkit.toolkit.utils.prompt_lint import lint_prompt
+
+    warnings = lint_prompt(
+        "You are an assistant. Escalate to jane.doe@example.com or call 13812345678."
+    )
+    assert any("email address" in w for w in warnings)
+    assert any("mobile phone number" in w for w in warnings)
+
+
+def test_overlapping_placeholders_are_reported_once():
+    from agentkit.toolkit.utils.prompt_lint import lint_prompt
+
+    warnings = lint_prompt("You are an assistant. [TODO: describe the tone].")
+    assert warnings == [
+        "Unresolved bracket placeholder found: '[TODO: describe the tone]'."
+    ]
+
+    warnings = lint_prompt("You are an assistant. [TODO: tone]. TODO: add examples.")
+    assert any("bracket placeholder" in w for w in warnings)
+    assert any("to-do marker found: 'TODO'" in w for w in warnings)
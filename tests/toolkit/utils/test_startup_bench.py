@@ -0,0 +1,86 @@
+# Copyright (c) 2026 Beijing Volcano Engine Technology Co., Ltd. and/or its affiliates.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+import socket
+import sys
+from pathlib import Path
+
+import pytest
+
+FAKE_AGENT = """\
+import http.server, sys
+
+port, ready_after = int(sys.argv[1]), int(sys.argv[2])
+probes = 0
+
+class Handler(http.server.BaseHTTPRequestHandler):
+    def do_GET(self):
+        global probes
+        probes += 1
+        if ready_after < 0:
+            self.send_response(404)
+        else:
+            self.send_response(200 if probes > ready_after else 503)
+        self.end_headers()
+
+    def log_message(self, *args):
+        pass
+
+http.server.HTTPServer(("127.0.0.1", port), Handler).serve_forever()
+"""
+
+
+def _free_port() -> int:
+    with socket.socket() as sock:
+        sock.bind(("127.0.0.1", 0))
+        return sock.getsockname()[1]
+
+
+def _fake_agent(tmp_path: Path, port: int, ready_after: int) -> list:
+    script = tmp_path / "agent.py"
+    script.write_text(FAKE_AGENT, encoding="utf-8")
+    return [sys.executable, str(script), str(port), str(ready_after)]
+
+
+@pytest.mark.parametrize("ready_after, probe", [(3, True), (-1, False)])
+def test_time_to_ready(tmp_path: Path, ready_after: int, probe: bool):
+    from agentkit.toolkit.utils.startup_bench import time_to_ready
+
+    port = _free_port()
+    command = _fake_agent(tmp_path, port, ready_after)
+
+    seconds, used_probe = time_to_ready(command, tmp_path, port, timeout=30)
+
+    assert seconds > 0
+    assert used_probe is probe
+    # The agent is stopped once it is ready.
+    with socket.socket() as sock:
+        assert sock.connect_ex(("127.0.0.1", port)) != 0
+
+
+def test_time_to_ready_reports_agent_exit(tmp_path: Path):
+    from agentkit.toolkit.utils.startup_bench import StartupBenchError, time_to_ready
+
+    script = "import sys; print('missing API key'); sys.exit(3)"
+    command = [sys.executable, "-c", script]
+
+    with pytest.raises(StartupBenchError, match="code 3.*missing API key"):
+        time_to_ready(command, tmp_path, _free_port(), timeout=30)
+
+
+def test_bench_startup_requires_go_module(tmp_path: Path):
+    from agentkit.toolkit.utils.startup_bench import StartupBenchError, bench_startup
+
+    with pytest.raises(StartupBenchError, match="not a Go module"):
+        bench_startup(tmp_path)